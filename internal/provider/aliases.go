@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// AliasListResponse is the LISTALIASES response.
+type AliasListResponse struct {
+	ResponseHeader ResponseHeader `json:"responseHeader"`
+	// Aliases maps each alias to its comma-separated target collections.
+	Aliases map[string]string `json:"aliases"`
+	// Properties holds the alias properties, including the router.* settings
+	// of routed aliases.
+	Properties map[string]map[string]string `json:"properties"`
+}
+
+// ListAliases returns every alias with its target collections and properties.
+func (c *Client) ListAliases(ctx context.Context) (AliasListResponse, error) {
+	var response AliasListResponse
+
+	body, err := c.collectionsAPI(ctx, "LISTALIASES", nil)
+	if err != nil {
+		return response, err
+	}
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return response, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	return response, nil
+}
+
+// aliasCollections splits an alias target list into collection names,
+// trimming spaces and skipping empty entries.
+func aliasCollections(targets string) []string {
+	var collections []string
+	for _, target := range strings.Split(targets, ",") {
+		if target = strings.TrimSpace(target); target != "" {
+			collections = append(collections, target)
+		}
+	}
+	return collections
+}
@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAliasCollections(t *testing.T) {
+	for _, test := range []struct {
+		targets string
+		want    []string
+	}{
+		{"", nil},
+		{"films", []string{"films"}},
+		{"films_2023,films_2024", []string{"films_2023", "films_2024"}},
+		{"films_2023, films_2024 ", []string{"films_2023", "films_2024"}},
+		{"films_2023,,films_2024,", []string{"films_2023", "films_2024"}},
+		{" , ", nil},
+	} {
+		assert.Equal(t, test.want, aliasCollections(test.targets), test.targets)
+	}
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// asyncPollInterval is how often REQUESTSTATUS is polled while waiting for an
+// async Collections API call to finish.
+var asyncPollInterval = 2 * time.Second
+
+// AsyncStatusResponse is the REQUESTSTATUS response for an async request id.
+type AsyncStatusResponse struct {
+	ResponseHeader ResponseHeader `json:"responseHeader"`
+	Status         struct {
+		State string `json:"state"`
+		Msg   string `json:"msg"`
+	} `json:"status"`
+	Exception *struct {
+		Msg     string `json:"msg"`
+		RspCode int    `json:"rspCode"`
+	} `json:"exception"`
+}
+
+// newAsyncID returns a request id that is unique enough for the lifetime of
+// a single Solr overseer queue entry.
+func newAsyncID(action string) string {
+	return fmt.Sprintf("tf-%s-%s", strings.ToLower(action), strconv.FormatInt(time.Now().UnixNano(), 36))
+}
+
+// collectionsAPIAsync submits a Collections API call with an async id and
+// blocks until Solr reports the request as completed or failed.
+func (c *Client) collectionsAPIAsync(ctx context.Context, action string, params url.Values) error {
+	if params == nil {
+		params = url.Values{}
+	}
+	id := newAsyncID(action)
+	params.Set("async", id)
+
+	if _, err := c.collectionsAPI(ctx, action, params); err != nil && !isDuplicateAsyncID(err) {
+		return err
+	}
+
+	return c.WaitForAsync(ctx, id)
+}
+
+// isDuplicateAsyncID reports whether err is Solr refusing an async id it
+// already knows. Since ids are unique per call, this means a retried request
+// was already accepted by an earlier attempt.
+func isDuplicateAsyncID(err error) bool {
+	return strings.Contains(err.Error(), "requestid already exists")
+}
+
+// GetRequestStatus returns the state of an async request.
+func (c *Client) GetRequestStatus(ctx context.Context, requestID string) (AsyncStatusResponse, error) {
+	var response AsyncStatusResponse
+
+	body, err := c.collectionsAPI(ctx, "REQUESTSTATUS", url.Values{"requestid": {requestID}})
+	if err != nil {
+		return response, err
+	}
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return response, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	return response, nil
+}
+
+// DeleteRequestStatus removes a finished async request from the overseer's
+// status queue.
+func (c *Client) DeleteRequestStatus(ctx context.Context, requestID string) error {
+	_, err := c.collectionsAPI(ctx, "DELETESTATUS", url.Values{"requestid": {requestID}})
+	return err
+}
+
+// WaitForAsync polls REQUESTSTATUS until the request completes, fails or the
+// context is cancelled.
+func (c *Client) WaitForAsync(ctx context.Context, requestID string) error {
+	for {
+		status, err := c.GetRequestStatus(ctx, requestID)
+		if err != nil {
+			return err
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Async request %s is %s", requestID, status.Status.State))
+
+		switch status.Status.State {
+		case "completed":
+			if err := c.DeleteRequestStatus(ctx, requestID); err != nil {
+				tflog.Warn(ctx, fmt.Sprintf("Unable to clear status of async request %s: %s", requestID, err))
+			}
+			return nil
+		case "failed":
+			if err := c.DeleteRequestStatus(ctx, requestID); err != nil {
+				tflog.Warn(ctx, fmt.Sprintf("Unable to clear status of async request %s: %s", requestID, err))
+			}
+			failure := &SolrError{Msg: status.Status.Msg}
+			if status.Exception != nil {
+				failure = &SolrError{StatusCode: status.Exception.RspCode, Code: status.Exception.RspCode, Msg: status.Exception.Msg}
+			}
+			return fmt.Errorf("async request %s failed: %w", requestID, failure)
+		case "notfound":
+			return fmt.Errorf("async request %s not found", requestID)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(asyncPollInterval):
+		}
+	}
+}
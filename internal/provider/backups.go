@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// BackupRequest holds the BACKUP parameters for a collection.
+type BackupRequest struct {
+	Collection         string
+	Name               string
+	Location           string
+	Repository         string
+	Incremental        bool
+	MaxNumBackupPoints int
+}
+
+// BackupCollection backs up a collection and waits for the backup to finish.
+func (c *Client) BackupCollection(ctx context.Context, request BackupRequest) error {
+	if request.Incremental {
+		if err := c.requireSolrVersion(ctx, solrVersionIncrementalBackup, "incremental backups"); err != nil {
+			return err
+		}
+	}
+
+	params := url.Values{}
+	params.Set("collection", request.Collection)
+	params.Set("name", request.Name)
+	params.Set("incremental", strconv.FormatBool(request.Incremental))
+	if request.Location != "" {
+		params.Set("location", request.Location)
+	}
+	if request.Repository != "" {
+		params.Set("repository", request.Repository)
+	}
+	if request.MaxNumBackupPoints > 0 {
+		params.Set("maxNumBackupPoints", strconv.Itoa(request.MaxNumBackupPoints))
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Backing up collection %s to %s", request.Collection, request.Name))
+
+	return c.collectionsAPIAsync(ctx, "BACKUP", params)
+}
+
+// RestoreRequest holds the RESTORE parameters for creating a collection from a backup.
+type RestoreRequest struct {
+	Collection string
+	Name       string
+	Location   string
+	Repository string
+	BackupID   int
+}
+
+// RestoreCollection creates a collection from a backup and waits for the
+// restore to finish. A negative BackupID restores the most recent backup point.
+func (c *Client) RestoreCollection(ctx context.Context, request RestoreRequest) error {
+	params := url.Values{}
+	params.Set("collection", request.Collection)
+	params.Set("name", request.Name)
+	if request.Location != "" {
+		params.Set("location", request.Location)
+	}
+	if request.Repository != "" {
+		params.Set("repository", request.Repository)
+	}
+	if request.BackupID >= 0 {
+		params.Set("backupId", strconv.Itoa(request.BackupID))
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Restoring collection %s from backup %s", request.Collection, request.Name))
+
+	return c.collectionsAPIAsync(ctx, "RESTORE", params)
+}
+
+// BackupInfo describes a single incremental backup point returned by LISTBACKUP.
+type BackupInfo struct {
+	BackupID       int     `json:"backupId"`
+	Collection     string  `json:"collection"`
+	ConfigName     string  `json:"collection.configName"`
+	IndexVersion   string  `json:"indexVersion"`
+	StartTime      string  `json:"startTime"`
+	EndTime        string  `json:"endTime"`
+	IndexFileCount int     `json:"indexFileCount"`
+	IndexSizeMB    float64 `json:"indexSizeMB"`
+}
+
+// ListBackupResponse is the LISTBACKUP response.
+type ListBackupResponse struct {
+	ResponseHeader ResponseHeader `json:"responseHeader"`
+	Collection     string         `json:"collection"`
+	Backups        []BackupInfo   `json:"backups"`
+}
+
+// ListBackups returns the backup points stored under a backup name.
+func (c *Client) ListBackups(ctx context.Context, name, location, repository string) (ListBackupResponse, error) {
+	var response ListBackupResponse
+
+	if err := c.requireSolrVersion(ctx, solrVersionIncrementalBackup, "LISTBACKUP"); err != nil {
+		return response, err
+	}
+
+	params := url.Values{}
+	params.Set("name", name)
+	if location != "" {
+		params.Set("location", location)
+	}
+	if repository != "" {
+		params.Set("repository", repository)
+	}
+
+	body, err := c.collectionsAPI(ctx, "LISTBACKUP", params)
+	if err != nil {
+		return response, err
+	}
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return response, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testBackupServer serves a Solr cluster of the given version that completes
+// every async request, and records the BACKUP calls it receives. LISTBACKUP
+// answers with the given backups.
+func testBackupServer(t *testing.T, version, backups string, calls *[]url.Values) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/solr/admin/info/system" {
+			fmt.Fprintf(w, `{"lucene":{"solr-spec-version":%q}}`, version)
+			return
+		}
+
+		switch r.URL.Query().Get("action") {
+		case "BACKUP":
+			*calls = append(*calls, r.URL.Query())
+			fmt.Fprint(w, `{}`)
+		case "LISTBACKUP":
+			fmt.Fprintf(w, `{"collection":"films","backups":[%s]}`, backups)
+		case "REQUESTSTATUS":
+			fmt.Fprint(w, `{"status":{"state":"completed"}}`)
+		case "DELETESTATUS":
+			fmt.Fprint(w, `{}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+		}
+	}))
+}
+
+func TestBackupCollection(t *testing.T) {
+	var calls []url.Values
+	server := testBackupServer(t, "9.4.0", "", &calls)
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{Host: server.URL})
+	require.NoError(t, err)
+
+	err = client.BackupCollection(context.Background(), BackupRequest{
+		Collection:         "films",
+		Name:               "nightly",
+		Location:           "/backups",
+		Repository:         "s3",
+		Incremental:        true,
+		MaxNumBackupPoints: 7,
+	})
+	require.NoError(t, err)
+
+	err = client.BackupCollection(context.Background(), BackupRequest{
+		Collection: "films",
+		Name:       "full",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, calls, 2)
+	assert.Equal(t, "films", calls[0].Get("collection"))
+	assert.Equal(t, "nightly", calls[0].Get("name"))
+	assert.Equal(t, "/backups", calls[0].Get("location"))
+	assert.Equal(t, "s3", calls[0].Get("repository"))
+	assert.Equal(t, "true", calls[0].Get("incremental"))
+	assert.Equal(t, "7", calls[0].Get("maxNumBackupPoints"))
+	assert.NotEmpty(t, calls[0].Get("async"))
+
+	assert.Equal(t, "false", calls[1].Get("incremental"))
+	for _, name := range []string{"location", "repository", "maxNumBackupPoints"} {
+		assert.False(t, calls[1].Has(name), name)
+	}
+}
+
+// TestBackupCollectionIncrementalVersion checks that incremental backups are
+// refused before Solr 8.9 without calling BACKUP.
+func TestBackupCollectionIncrementalVersion(t *testing.T) {
+	var calls []url.Values
+	server := testBackupServer(t, "8.8.2", "", &calls)
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{Host: server.URL})
+	require.NoError(t, err)
+
+	err = client.BackupCollection(context.Background(), BackupRequest{Collection: "films", Name: "nightly", Incremental: true})
+	assert.Error(t, err)
+	assert.Empty(t, calls)
+}
@@ -1,11 +1,17 @@
 package provider
 
 import (
+	"bytes"
+	"context"
 	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
-	"net/http/httputil"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -14,12 +20,23 @@ import (
 // HostURL - Default Hashicups URL
 const HostURL string = "http://localhost:8983"
 
+// defaultRequestTimeout bounds a single HTTP request when no request timeout
+// is configured.
+const defaultRequestTimeout = 10 * time.Second
+
 // Client -
 type Client struct {
 	HostURL    string
 	HTTPClient *http.Client
 	Token      string
 	Auth       AuthStruct
+
+	version *solrVersionCache
+	hosts   *hostPool
+	retry   RetryConfig
+	auth    middleware
+
+	disableBodyLogging bool
 }
 
 // AuthStruct -
@@ -30,60 +47,178 @@ type AuthStruct struct {
 
 // AuthResponse -
 type AuthResponse struct {
-	UserID   int    `json:"user_id`
-	Username string `json:"username`
+	UserID   int    `json:"user_id"`
+	Username string `json:"username"`
 	Token    string `json:"token"`
 }
 
+// ClientConfig holds the settings a Client is built from. Empty fields fall
+// back to their defaults.
+type ClientConfig struct {
+	Host     string
+	Hosts    []string
+	Username string
+	Password string
+	TLS      TLSConfig
+	Retry    RetryConfig
+
+	// RequestTimeout bounds each HTTP request, including reading the
+	// response. Defaults to 10 seconds.
+	RequestTimeout time.Duration
+
+	// DisableBodyLogging keeps request bodies out of the TRACE logs.
+	DisableBodyLogging bool
+
+	// ZooKeeper ensemble, as host:port pairs, and chroot to discover the
+	// live Solr nodes from.
+	ZKHosts  []string
+	ZKChroot string
+
+	// Bearer token authentication; at most one of these is set.
+	Token     string
+	TokenFile string
+	OAuth2    *OAuth2Config
+}
+
 // NewClient -
-func NewClient(host, username, password *string) (*Client, error) {
+func NewClient(config ClientConfig) (*Client, error) {
+	transport, err := newTransport(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := config.RequestTimeout
+	if timeout <= 0 {
+		timeout = defaultRequestTimeout
+	}
+
 	c := Client{
-		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		HTTPClient: &http.Client{Timeout: timeout, Transport: transport},
 		HostURL:    HostURL,
+		// The Solr version is detected on the first request that needs it
+		// and cached for the lifetime of this provider instance.
+		version: &solrVersionCache{},
+		retry:   config.Retry,
+
+		disableBodyLogging: config.DisableBodyLogging,
+	}
+
+	hosts := config.Hosts
+	if config.Host != "" {
+		hosts = append([]string{config.Host}, hosts...)
+	}
+	if len(hosts) > 0 {
+		c.HostURL = hosts[0]
 	}
 
-	if host != nil {
-		c.HostURL = *host
+	var source liveNodesSource
+	if len(config.ZKHosts) > 0 {
+		source, err = newZKLiveNodes(config.ZKHosts, config.ZKChroot)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	if username == nil || password == nil {
-		return &c, nil
+	c.hosts, err = newHostPool(hosts, source)
+	if err != nil {
+		return nil, err
 	}
 
-	if username != nil && password != nil {
-		basicAuth := base64.StdEncoding.EncodeToString([]byte(*username + ":" + *password))
-		c.HTTPClient.Transport = &basicAuthTransport{
-			Transport: http.DefaultTransport,
-			Username:  *username,
-			Password:  *password,
-			BasicAuth: "Basic " + basicAuth,
+	var tokens tokenSource
+	switch {
+	case config.Token != "":
+		c.Token = config.Token
+		tokens = staticTokenSource(config.Token)
+	case config.TokenFile != "":
+		tokens = &fileTokenSource{path: config.TokenFile}
+	case config.OAuth2 != nil:
+		tokens = &oauth2TokenSource{
+			config:     *config.OAuth2,
+			httpClient: &http.Client{Timeout: c.HTTPClient.Timeout, Transport: transport},
 		}
 	}
 
+	switch {
+	case tokens != nil:
+		c.auth = bearerAuth(tokens)
+	case config.Username != "" || config.Password != "":
+		c.auth = basicAuth(config.Username, config.Password)
+	}
+
 	return &c, nil
 }
 
-type basicAuthTransport struct {
-	Transport http.RoundTripper
-	Username  string
-	Password  string
-	BasicAuth string
+// requestFunc sends a request and returns the body of a 200 response.
+type requestFunc func(req *http.Request) ([]byte, error)
+
+// middleware is a stage of the request pipeline. It handles a request by
+// calling next, possibly several times or with a modified request.
+type middleware func(next requestFunc) requestFunc
+
+// doRequest sends req through the request pipeline and returns the body of
+// a 200 response. Every call to the Solr API goes through it.
+func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+	return c.pipeline()(req)
 }
 
-func (bat *basicAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	req.Header.Set("Authorization", bat.BasicAuth)
-	return bat.Transport.RoundTrip(req)
+// pipeline returns the request pipeline. From the outside in, it records
+// metrics, retries transient failures with backoff, fails over between
+// nodes, logs each attempt and authenticates it.
+func (c *Client) pipeline() requestFunc {
+	stages := []middleware{recordMetrics, c.retryRequests, c.failOver, c.logRequests}
+	if c.auth != nil {
+		stages = append(stages, c.auth)
+	}
+
+	handler := c.send
+	for i := len(stages) - 1; i >= 0; i-- {
+		handler = stages[i](handler)
+	}
+	return handler
 }
 
-func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+// getJSON sends a GET request to url and decodes the JSON response into out.
+func (c *Client) getJSON(ctx context.Context, url string, out interface{}) error {
+	return c.sendJSON(ctx, "GET", url, nil, out)
+}
+
+// sendJSON sends a request with in, unless nil, as its JSON body and decodes
+// the JSON response into out, unless nil.
+func (c *Client) sendJSON(ctx context.Context, method, url string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("error marshalling request data: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
 
-	requestDump, err := httputil.DumpRequestOut(req, true)
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
-		fmt.Println("Error dumping request:", err)
-		return nil, err
+		return fmt.Errorf("error creating request: %w", err)
 	}
-	ctx := req.Context()
-	tflog.Info(ctx, string(requestDump))
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	data, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("error unmarshalling response: %w", err)
+	}
+	return nil
+}
+
+// send is the end of the request pipeline: it makes the HTTP request and
+// reads and closes the response body.
+func (c *Client) send(req *http.Request) ([]byte, error) {
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
 		return nil, err
@@ -91,14 +226,224 @@ func (c *Client) doRequest(req *http.Request) ([]byte, error) {
 	defer res.Body.Close()
 
 	body, err := io.ReadAll(res.Body)
-
 	if err != nil {
 		return nil, err
 	}
 
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+		return nil, newSolrError(res.StatusCode, body)
+	}
+
+	return body, nil
+}
+
+// basicAuth authenticates requests with HTTP basic authentication.
+func basicAuth(username, password string) middleware {
+	header := "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+
+	return func(next requestFunc) requestFunc {
+		return func(req *http.Request) ([]byte, error) {
+			req = req.Clone(req.Context())
+			req.Header.Set("Authorization", header)
+			return next(req)
+		}
+	}
+}
+
+// bearerAuth authenticates requests with the token of tokens as a bearer
+// token, as expected by Solr's JWTAuthPlugin.
+func bearerAuth(tokens tokenSource) middleware {
+	return func(next requestFunc) requestFunc {
+		return func(req *http.Request) ([]byte, error) {
+			token, err := tokens.Token(req.Context())
+			if err != nil {
+				return nil, fmt.Errorf("unable to obtain bearer token: %w", err)
+			}
+
+			req = req.Clone(req.Context())
+			req.Header.Set("Authorization", "Bearer "+token)
+			return next(req)
+		}
+	}
+}
+
+// recordMetrics logs the duration and outcome of each request, including
+// its retries.
+func recordMetrics(next requestFunc) requestFunc {
+	return func(req *http.Request) ([]byte, error) {
+		start := time.Now()
+		body, err := next(req)
+
+		fields := map[string]interface{}{
+			"method":      req.Method,
+			"path":        req.URL.Path,
+			"duration_ms": time.Since(start).Milliseconds(),
+			"success":     err == nil,
+		}
+		var solrErr *SolrError
+		if errors.As(err, &solrErr) {
+			fields["status"] = solrErr.StatusCode
+		}
+		tflog.Debug(req.Context(), "SolrCloud request finished", fields)
+
+		return body, err
+	}
+}
+
+// failOver sends requests to HostURL to the next healthy node when several
+// hosts are configured, and to another node when one is unreachable or
+// unavailable. Requests pinned to a node with withPinnedNode are left alone.
+func (c *Client) failOver(next requestFunc) requestFunc {
+	return func(req *http.Request) ([]byte, error) {
+		origin, err := hostOrigin(c.HostURL)
+		if c.hosts == nil || err != nil || isPinnedNode(req) || req.URL.Scheme+"://"+req.URL.Host != origin {
+			return next(req)
+		}
+
+		return c.sendToCluster(req, next)
+	}
+}
+
+// sendToCluster tries the nodes of the host pool in turn with next.
+func (c *Client) sendToCluster(req *http.Request, next requestFunc) ([]byte, error) {
+	ctx := req.Context()
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+	var lastErr error
+	if err := c.hosts.refresh(ctx); err != nil {
+		tflog.Warn(ctx, "Unable to discover SolrCloud live nodes", map[string]interface{}{
+			"error": err.Error(),
+		})
+		lastErr = err
+	}
+
+	for _, target := range c.hosts.candidates() {
+		attempt, err := requestTo(req, target)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := next(attempt)
+		if err == nil {
+			c.hosts.markUp(target)
+			if c.hosts.needsDiscovery() {
+				c.discoverLiveNodes(ctx)
+			}
+			return body, nil
+		}
+
+		if ctx.Err() != nil || !canFailOver(req, err) {
+			return nil, err
+		}
+
+		tflog.Warn(ctx, "SolrCloud node unavailable, trying another node", map[string]interface{}{
+			"node":  target,
+			"error": err.Error(),
+		})
+		c.hosts.markDown(target)
+		lastErr = err
+
+		if !replayable {
+			break
+		}
+	}
+
+	if lastErr == nil {
+		lastErr = errors.New("no SolrCloud nodes available")
+	}
+	return nil, lastErr
+}
+
+// nodeURL returns the URL to send requests meant for a single node to, such
+// as system info and metrics: HostURL, moved to the preferred node of the
+// host pool when the client has one. Such requests are pinned to that node
+// with withPinnedNode so that its errors are not hidden by failover.
+func (c *Client) nodeURL(ctx context.Context) string {
+	origin, err := hostOrigin(c.HostURL)
+	if c.hosts == nil || err != nil {
+		return c.HostURL
+	}
+
+	if err := c.hosts.refresh(ctx); err != nil {
+		tflog.Warn(ctx, "Unable to discover SolrCloud live nodes", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	node := c.hosts.preferred()
+	if node == "" {
+		return c.HostURL
+	}
+	return node + strings.TrimPrefix(c.HostURL, origin)
+}
+
+// requestTo returns a copy of req sent to the node at origin.
+func requestTo(req *http.Request, origin string) (*http.Request, error) {
+	u, err := url.Parse(origin)
+	if err != nil {
+		return nil, err
+	}
+
+	attempt := req.Clone(req.Context())
+	attempt.URL.Scheme = u.Scheme
+	attempt.URL.Host = u.Host
+	attempt.Host = u.Host
+
+	if req.GetBody != nil {
+		attempt.Body, err = req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return attempt, nil
+}
+
+// canFailOver reports whether a request that failed with err may be sent
+// to another node. Requests that never reached a node are always sent
+// again; others only when isRetrySafe allows it, on connection errors and
+// 503 Service Unavailable.
+func canFailOver(req *http.Request, err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	if !isRetrySafe(req) {
+		return false
+	}
+
+	var solrErr *SolrError
+	if errors.As(err, &solrErr) {
+		return solrErr.StatusCode == http.StatusServiceUnavailable
+	}
+
+	var urlErr *url.Error
+	return errors.As(err, &urlErr) && !isCertificateError(err)
+}
+
+// discoverLiveNodes looks up the live nodes of the cluster so that they are
+// preferred for later requests.
+func (c *Client) discoverLiveNodes(ctx context.Context) {
+	if _, err := c.GetClusterStatus(ctx, ClusterStatusFilter{}); err != nil {
+		tflog.Debug(ctx, "Unable to discover SolrCloud live nodes", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+}
+
+// collectionsAPI issues a v1 Collections API call for the given action and
+// returns the raw response body.
+func (c *Client) collectionsAPI(ctx context.Context, action string, params url.Values) ([]byte, error) {
+	if params == nil {
+		params = url.Values{}
+	}
+	params.Set("action", action)
+	params.Set("wt", "json")
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/solr/admin/collections?%s", c.HostURL, params.Encode()), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 
-	return body, err
+	return c.doRequest(req)
 }
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, _ := r.BasicAuth()
+		assert.Equal(t, "solr", username)
+		assert.Equal(t, "SolrRocks", password)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var request CollectionCreationRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		assert.Equal(t, "films", request.Name)
+
+		w.Write([]byte(`{"responseHeader":{"status":0,"QTime":12}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{Host: server.URL, Username: "solr", Password: "SolrRocks"})
+	require.NoError(t, err)
+
+	var response struct {
+		ResponseHeader ResponseHeader `json:"responseHeader"`
+	}
+	err = client.sendJSON(context.Background(), "POST", server.URL+"/api/collections", CollectionCreationRequest{Name: "films"}, &response)
+	require.NoError(t, err)
+	assert.Equal(t, 12, response.ResponseHeader.QTime)
+}
+
+func TestPipelineAuthStage(t *testing.T) {
+	var stages []string
+	stage := func(name string) middleware {
+		return func(next requestFunc) requestFunc {
+			return func(req *http.Request) ([]byte, error) {
+				stages = append(stages, name)
+				return next(req)
+			}
+		}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		stages = append(stages, "server")
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{Host: server.URL})
+	require.NoError(t, err)
+	client.auth = stage("auth")
+
+	require.NoError(t, client.getJSON(context.Background(), server.URL, nil))
+	assert.Equal(t, []string{"auth", "server"}, stages)
+}
@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource              = &collectionBackupResource{}
+	_ resource.ResourceWithConfigure = &collectionBackupResource{}
+)
+
+// NewCollectionBackupResource is a helper function to simplify the provider implementation.
+func NewCollectionBackupResource() resource.Resource {
+	return &collectionBackupResource{}
+}
+
+// collectionBackupResource takes a backup of a collection when it is created
+// or replaced.
+type collectionBackupResource struct {
+	client Client
+}
+
+// CollectionBackupResourceModel is the model for the solrcloud_collection_backup resource.
+type CollectionBackupResourceModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	Collection         types.String   `tfsdk:"collection"`
+	Name               types.String   `tfsdk:"name"`
+	Location           types.String   `tfsdk:"location"`
+	Repository         types.String   `tfsdk:"repository"`
+	Incremental        types.Bool     `tfsdk:"incremental"`
+	MaxNumBackupPoints types.Int64    `tfsdk:"max_num_backup_points"`
+	Triggers           types.Map      `tfsdk:"triggers"`
+	BackupID           types.Int64    `tfsdk:"backup_id"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *collectionBackupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = *client
+}
+
+// Schema defines the schema for the resource.
+func (r *collectionBackupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	replace := []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Backs up a collection with the BACKUP API. A new backup is taken whenever any argument, including triggers, changes. " +
+			"Destroying the resource only removes it from state; the backup data is kept.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The backup identifier in the form collection/name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collection": schema.StringAttribute{
+				Required:      true,
+				Description:   "The name of the collection to back up.",
+				PlanModifiers: replace,
+			},
+			"name": schema.StringAttribute{
+				Required:      true,
+				Description:   "The name of the backup.",
+				PlanModifiers: replace,
+			},
+			"location": schema.StringAttribute{
+				Optional:      true,
+				Description:   "The location in the backup repository to write the backup to.",
+				PlanModifiers: replace,
+			},
+			"repository": schema.StringAttribute{
+				Optional:      true,
+				Description:   "The name of the backup repository configured in solr.xml.",
+				PlanModifiers: replace,
+			},
+			"incremental": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether to take an incremental backup. Defaults to true.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_num_backup_points": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The number of incremental backup points to keep; older points are purged.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary values that take a new backup when they change, e.g. a CI build number.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"backup_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The id of the backup point created for incremental backups.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true}),
+		},
+	}
+}
+
+func (r *collectionBackupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collection_backup"
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *collectionBackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CollectionBackupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts, "create", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.BackupCollection(ctx, BackupRequest{
+		Collection:         plan.Collection.ValueString(),
+		Name:               plan.Name.ValueString(),
+		Location:           plan.Location.ValueString(),
+		Repository:         plan.Repository.ValueString(),
+		Incremental:        plan.Incremental.ValueBool(),
+		MaxNumBackupPoints: int(plan.MaxNumBackupPoints.ValueInt64()),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error backing up collection", "Could not back up collection, unexpected error: ", err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.Collection.ValueString() + "/" + plan.Name.ValueString())
+	plan.BackupID = types.Int64Null()
+
+	if plan.Incremental.ValueBool() {
+		backups, err := r.client.ListBackups(ctx, plan.Name.ValueString(), plan.Location.ValueString(), plan.Repository.ValueString())
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Error listing backups", "Could not list backups, unexpected error: ", err)
+			return
+		}
+		for _, backup := range backups.Backups {
+			if plan.BackupID.IsNull() || int64(backup.BackupID) > plan.BackupID.ValueInt64() {
+				plan.BackupID = types.Int64Value(int64(backup.BackupID))
+			}
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read keeps the recorded backup; backups are immutable once taken.
+func (r *collectionBackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state CollectionBackupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, state.Timeouts, "read", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only stores changes to the timeouts block, because every other
+// argument requires replacement.
+func (r *collectionBackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan CollectionBackupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the resource from state and leaves the backup in the repository.
+func (r *collectionBackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
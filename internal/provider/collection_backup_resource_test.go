@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCollectionBackupResourceBackupID checks that Create records the highest
+// backup point LISTBACKUP returns, whatever the order of the list.
+func TestCollectionBackupResourceBackupID(t *testing.T) {
+	var calls []url.Values
+	server := testBackupServer(t, "9.4.0", `{"backupId":0},{"backupId":2},{"backupId":1}`, &calls)
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{Host: server.URL})
+	require.NoError(t, err)
+	r := &collectionBackupResource{client: *client}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw: testObject(objectType, map[string]tftypes.Value{
+			"id":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"collection":  tftypes.NewValue(tftypes.String, "films"),
+			"name":        tftypes.NewValue(tftypes.String, "nightly"),
+			"incremental": tftypes.NewValue(tftypes.Bool, true),
+			"backup_id":   tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		}),
+	}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: testObject(objectType, nil)}}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	require.Len(t, calls, 1)
+
+	var state CollectionBackupResourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	assert.Equal(t, "films/nightly", state.ID.ValueString())
+	assert.Equal(t, int64(2), state.BackupID.ValueInt64())
+}
@@ -3,17 +3,28 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ resource.Resource              = &collectionResource{}
-	_ resource.ResourceWithConfigure = &collectionResource{}
+	_ resource.Resource                   = &collectionResource{}
+	_ resource.ResourceWithConfigure      = &collectionResource{}
+	_ resource.ResourceWithValidateConfig = &collectionResource{}
+	_ resource.ResourceWithModifyPlan     = &collectionResource{}
+	_ resource.ResourceWithImportState    = &collectionResource{}
 )
 
 // NewCollectionResource is a helper function to simplify the provider implementation.
@@ -28,11 +39,80 @@ type collectionResource struct {
 
 // CollectionResourceModel is the model for the solrcloud_collection resource.
 type CollectionResourceModel struct {
-	Name              types.String   `tfsdk:"name"`
-	NumShards         types.Int64    `tfsdk:"num_shards"`
-	ReplicationFactor types.Int64    `tfsdk:"replication_factor"`
-	Shards            []types.String `tfsdk:"shards"`
-	Router            types.String   `tfsdk:"router"`
+	Name              types.String            `tfsdk:"name"`
+	NumShards         types.Int64             `tfsdk:"num_shards"`
+	ReplicationFactor types.Int64             `tfsdk:"replication_factor"`
+	Shards            []types.String          `tfsdk:"shards"`
+	Router            types.String            `tfsdk:"router"`
+	Split             *CollectionSplitModel   `tfsdk:"split"`
+	RestoreFrom       *CollectionRestoreModel `tfsdk:"restore_from"`
+	Health            types.String            `tfsdk:"health"`
+	ActiveReplicas    types.Int64             `tfsdk:"active_replicas"`
+	DownReplicas      types.Int64             `tfsdk:"down_replicas"`
+	Leaders           types.Map               `tfsdk:"leaders"`
+	Timeouts          timeouts.Value          `tfsdk:"timeouts"`
+}
+
+// CollectionRestoreModel names the backup a collection is restored from
+// instead of being created empty.
+type CollectionRestoreModel struct {
+	Name       types.String `tfsdk:"name"`
+	Location   types.String `tfsdk:"location"`
+	Repository types.String `tfsdk:"repository"`
+	BackupID   types.Int64  `tfsdk:"backup_id"`
+}
+
+// CollectionSplitModel configures how an increase of num_shards is applied
+// with SPLITSHARD instead of replacing the collection.
+type CollectionSplitModel struct {
+	Shard        types.String `tfsdk:"shard"`
+	SplitKey     types.String `tfsdk:"split_key"`
+	Ranges       types.String `tfsdk:"ranges"`
+	NumSubShards types.Int64  `tfsdk:"num_sub_shards"`
+	SplitMethod  types.String `tfsdk:"split_method"`
+}
+
+// targeted reports whether the split configuration names a single shard to
+// split rather than letting the provider pick shards automatically.
+func (m *CollectionSplitModel) targeted() bool {
+	return m.Shard.ValueString() != "" || m.SplitKey.ValueString() != "" || m.Ranges.ValueString() != ""
+}
+
+// numSubShards returns the configured number of sub-shards, defaulting to
+// Solr's own default of two.
+func (m *CollectionSplitModel) numSubShards() int {
+	if m.NumSubShards.IsNull() || m.NumSubShards.IsUnknown() {
+		return 2
+	}
+	return int(m.NumSubShards.ValueInt64())
+}
+
+// addedShards returns how many active shards a single split adds: the parent
+// shard is replaced by its sub-shards, one per range when ranges are given.
+func (m *CollectionSplitModel) addedShards() int {
+	if ranges := m.Ranges.ValueString(); ranges != "" {
+		return len(strings.Split(ranges, ",")) - 1
+	}
+	return m.numSubShards() - 1
+}
+
+// splitPlanError explains why splitting cannot take a collection from current
+// to planned active shards, or returns an empty string if it can.
+func splitPlanError(split *CollectionSplitModel, current, planned int) string {
+	added := split.addedShards()
+	if added < 1 {
+		return "A split must create at least two sub-shards."
+	}
+	if split.targeted() {
+		if current+added != planned {
+			return fmt.Sprintf("Splitting one shard of %d active shards into %d sub-shards leaves %d active shards, but num_shards is %d.", current, added+1, current+added, planned)
+		}
+		return ""
+	}
+	if (planned-current)%added != 0 {
+		return fmt.Sprintf("Splitting shards into %d sub-shards cannot take %d active shards to exactly %d.", added+1, current, planned)
+	}
+	return ""
 }
 
 // Configure adds the provider configured client to the resource.
@@ -56,40 +136,218 @@ func (r *collectionResource) Configure(_ context.Context, req resource.Configure
 }
 
 // Schema defines the schema for the resource.
-func (r *collectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *collectionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
 				Required:    true,
 				Description: "The name of the collection to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"num_shards": schema.Int64Attribute{
 				Optional:    true,
-				Description: "The number of shards to be created as part of the collection.",
+				Computed:    true,
+				Description: "The number of shards to be created as part of the collection. Increasing it on a compositeId collection with a split block splits existing shards, any other change replaces the collection. Read from the cluster when not set.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplaceIf(
+						numShardsRequiresReplace,
+						"Shard count changes require replacement unless they are an increase that can be applied with SPLITSHARD.",
+						"Shard count changes require replacement unless they are an increase that can be applied with `SPLITSHARD`.",
+					),
+				},
 			},
 			"replication_factor": schema.Int64Attribute{
 				Optional:    true,
-				Description: "The number of replicas to be created for each shard.",
+				Computed:    true,
+				Description: "The number of replicas to be created for each shard. Read from the cluster when not set. Changing it replaces the collection.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
 			},
 			"shards": schema.ListAttribute{
 				Optional:    true,
 				ElementType: types.StringType,
 				Description: "The shard names to use when creating this collection.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
 			},
 			"router": schema.StringAttribute{
 				Default:     stringdefault.StaticString("compositeId"),
 				Computed:    true,
 				Optional:    true,
 				Description: "The router to use when creating this collection.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"split": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Applies increases of num_shards on compositeId collections with SPLITSHARD. Without a target, the active shard with the widest hash range is split until num_shards is reached.",
+				Attributes: map[string]schema.Attribute{
+					"shard": schema.StringAttribute{
+						Optional:    true,
+						Description: "The name of the shard to split.",
+					},
+					"split_key": schema.StringAttribute{
+						Optional:    true,
+						Description: "Split the shard that contains this route key (split.key).",
+					},
+					"ranges": schema.StringAttribute{
+						Optional:    true,
+						Description: "Comma-separated hash ranges in hexadecimal to split the shard into, e.g. 0-1f4,1f5-3e8.",
+					},
+					"num_sub_shards": schema.Int64Attribute{
+						Optional:    true,
+						Description: "The number of sub-shards to split a shard into. Defaults to 2.",
+					},
+					"split_method": schema.StringAttribute{
+						Optional:    true,
+						Description: "The split method, either rewrite or link.",
+					},
+				},
+			},
+			"health": schema.StringAttribute{
+				Computed:    true,
+				Description: "The collection health reported by CLUSTERSTATUS: GREEN, YELLOW, ORANGE or RED.",
+			},
+			"active_replicas": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of active replicas across all active shards.",
+			},
+			"down_replicas": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of replicas of active shards that are not active, e.g. down or recovering.",
+			},
+			"leaders": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The leader replica of each active shard, keyed by shard name.",
+			},
+			"restore_from": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Create the collection with RESTORE from a backup instead of CREATE.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Required:    true,
+						Description: "The name of the backup to restore.",
+					},
+					"location": schema.StringAttribute{
+						Optional:    true,
+						Description: "The location in the backup repository.",
+					},
+					"repository": schema.StringAttribute{
+						Optional:    true,
+						Description: "The name of the backup repository.",
+					},
+					"backup_id": schema.Int64Attribute{
+						Optional:    true,
+						Description: "The incremental backup point to restore. Defaults to the most recent one.",
+					},
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
+// numShardsRequiresReplace forces replacement of the collection unless the
+// shard count grows on a compositeId collection that has a split block.
+func numShardsRequiresReplace(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsUnknown() || req.PlanValue.IsNull() {
+		resp.RequiresReplace = true
+		return
+	}
+
+	if req.PlanValue.ValueInt64() < req.StateValue.ValueInt64() {
+		resp.RequiresReplace = true
+		return
+	}
+
+	var router types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("router"), &router)...)
+
+	var split *CollectionSplitModel
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("split"), &split)...)
+
+	resp.RequiresReplace = router.ValueString() != "compositeId" || split == nil
+}
+
 func (r *collectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_collection"
 }
 
+// ValidateConfig checks that the split block is consistent.
+func (r *collectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var split *CollectionSplitModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("split"), &split)...)
+	if split == nil {
+		return
+	}
+
+	if !split.NumSubShards.IsNull() && !split.NumSubShards.IsUnknown() && split.NumSubShards.ValueInt64() < 2 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("split").AtName("num_sub_shards"),
+			"Invalid Shard Split",
+			fmt.Sprintf("num_sub_shards must be at least 2, got %d.", split.NumSubShards.ValueInt64()),
+		)
+	}
+	if !split.NumSubShards.IsNull() && (!split.Ranges.IsNull() || !split.SplitKey.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("split").AtName("num_sub_shards"),
+			"Invalid Shard Split",
+			"num_sub_shards cannot be combined with ranges or split_key.",
+		)
+	}
+	if !split.Ranges.IsNull() && split.Shard.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("split").AtName("ranges"),
+			"Invalid Shard Split",
+			"ranges requires shard to name the shard being split.",
+		)
+	}
+}
+
+// ModifyPlan checks that a planned num_shards increase can be reached with
+// the configured split before any shard is split.
+func (r *collectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state CollectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Split == nil || plan.NumShards.IsUnknown() || plan.Split.NumSubShards.IsUnknown() || plan.Split.Ranges.IsUnknown() {
+		return
+	}
+	if plan.Router.ValueString() != "compositeId" || plan.NumShards.ValueInt64() <= state.NumShards.ValueInt64() {
+		return
+	}
+
+	if msg := splitPlanError(plan.Split, int(state.NumShards.ValueInt64()), int(plan.NumShards.ValueInt64())); msg != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("num_shards"), "Invalid Shard Split", msg)
+	}
+}
+
+// ImportState imports an existing collection by name.
+func (r *collectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *collectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan CollectionResourceModel
@@ -99,28 +357,50 @@ func (r *collectionResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts, "create", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert plan.Shards from []types.String to []string
 	var shards []string
 	for _, shard := range plan.Shards {
-		shards = append(shards, shard.String())
+		shards = append(shards, shard.ValueString())
 	}
 
-	_, err := r.client.CreateCollection(ctx, plan.Name.ValueString(), int(plan.NumShards.ValueInt64()), int(plan.ReplicationFactor.ValueInt64()), shards)
+	var err error
+	if plan.RestoreFrom != nil {
+		backupID := -1
+		if !plan.RestoreFrom.BackupID.IsNull() {
+			backupID = int(plan.RestoreFrom.BackupID.ValueInt64())
+		}
+		err = r.client.RestoreCollection(ctx, RestoreRequest{
+			Collection: plan.Name.ValueString(),
+			Name:       plan.RestoreFrom.Name.ValueString(),
+			Location:   plan.RestoreFrom.Location.ValueString(),
+			Repository: plan.RestoreFrom.Repository.ValueString(),
+			BackupID:   backupID,
+		})
+	} else {
+		err = r.client.CreateCollection(ctx, plan.Name.ValueString(), int(plan.NumShards.ValueInt64()), int(plan.ReplicationFactor.ValueInt64()), shards, plan.Router.ValueString())
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating collection",
-			"Could not create collection, unexpected error: "+err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Error creating collection", "Could not create collection, unexpected error: ", err)
 		return
 	}
 
 	plan.Name = types.StringValue(plan.Name.ValueString())
-	plan.NumShards = types.Int64Value(plan.NumShards.ValueInt64())
-	plan.ReplicationFactor = types.Int64Value(plan.ReplicationFactor.ValueInt64())
 	plan.Router = types.StringValue(plan.Router.ValueString())
-	for _, shard := range plan.Shards {
-		plan.Shards = append(plan.Shards, types.StringValue(shard.String()))
+
+	collection, err := r.client.GetCollectionStatus(ctx, plan.Name.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading collection", "Could not read collection, unexpected error: ", err)
+		return
 	}
+	plan.NumShards = types.Int64Value(int64(len(activeShards(collection))))
+	plan.ReplicationFactor = types.Int64Value(int64(collection.ReplicationFactor))
+	resp.Diagnostics.Append(setCollectionHealth(ctx, &plan, collection)...)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -139,38 +419,178 @@ func (r *collectionResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	collection, err := r.client.GetCollectionStatus(state.Name.ValueString())
+	ctx, cancel := withTimeout(ctx, state.Timeouts, "read", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collection, err := r.client.GetCollectionStatus(ctx, state.Name.ValueString())
+	if isCollectionNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading collection",
-			"Could not read collection, unexpected error: "+err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Error reading collection", "Could not read collection, unexpected error: ", err)
 		return
 	}
 
 	state.Name = types.StringValue(state.Name.ValueString())
-	numReplicationFactor := strconv.Itoa(collection.ReplicationFactor)
 
 	// get collection router routerinfo name
 	state.Router = types.StringValue(collection.Router.Name)
-	replicationFactor, err := strconv.ParseInt(numReplicationFactor, 10, 64)
+	state.NumShards = types.Int64Value(int64(len(activeShards(collection))))
+	state.ReplicationFactor = types.Int64Value(int64(collection.ReplicationFactor))
+	resp.Diagnostics.Append(setCollectionHealth(ctx, &state, collection)...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *collectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state CollectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts, "update", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Split != nil && plan.NumShards.ValueInt64() > state.NumShards.ValueInt64() {
+		err := r.splitShards(ctx, plan.Name.ValueString(), int(plan.NumShards.ValueInt64()), plan.Split)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Error splitting collection shards", "Could not split shards of collection "+plan.Name.ValueString()+": ", err)
+			return
+		}
+	}
+
+	collection, err := r.client.GetCollectionStatus(ctx, plan.Name.ValueString())
 	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading collection", "Could not read collection, unexpected error: ", err)
 		return
 	}
+	plan.NumShards = types.Int64Value(int64(len(activeShards(collection))))
+	plan.ReplicationFactor = types.Int64Value(int64(collection.ReplicationFactor))
+	resp.Diagnostics.Append(setCollectionHealth(ctx, &plan, collection)...)
 
-	state.NumShards = types.Int64Value(replicationFactor)
-	state.ReplicationFactor = types.Int64Value(replicationFactor)
-	diags = resp.State.Set(ctx, state)
+	diags := resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
-// Update updates the resource and sets the updated Terraform state on success.
-func (r *collectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+// setCollectionHealth populates the computed health attributes from the
+// collection's CLUSTERSTATUS entry.
+func setCollectionHealth(ctx context.Context, model *CollectionResourceModel, collection CollectionInfo) diag.Diagnostics {
+	var active, down int64
+	leaders := map[string]string{}
+	for _, shard := range activeShards(collection) {
+		for name, replica := range collection.Shards[shard].Replicas {
+			if replica.State == "active" {
+				active++
+			} else {
+				down++
+			}
+			if replica.Leader == "true" {
+				leaders[shard] = name
+			}
+		}
+	}
+
+	model.Health = types.StringValue(collection.Health)
+	model.ActiveReplicas = types.Int64Value(active)
+	model.DownReplicas = types.Int64Value(down)
+
+	var diags diag.Diagnostics
+	model.Leaders, diags = types.MapValueFrom(ctx, types.StringType, leaders)
+	return diags
+}
+
+// splitShards issues SPLITSHARD calls until the collection has the requested
+// number of active shards.
+func (r *collectionResource) splitShards(ctx context.Context, name string, numShards int, split *CollectionSplitModel) error {
+	collection, err := r.client.GetCollectionStatus(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if split.targeted() {
+		err = r.client.SplitShard(ctx, ShardSplitRequest{
+			Collection:   name,
+			Shard:        split.Shard.ValueString(),
+			SplitKey:     split.SplitKey.ValueString(),
+			Ranges:       split.Ranges.ValueString(),
+			NumSubShards: int(split.NumSubShards.ValueInt64()),
+			SplitMethod:  split.SplitMethod.ValueString(),
+		})
+		if err != nil {
+			return err
+		}
+
+		collection, err = r.client.GetCollectionStatus(ctx, name)
+		if err != nil {
+			return err
+		}
+		if active := len(activeShards(collection)); active != numShards {
+			return fmt.Errorf("split left %d active shards, but num_shards is %d", active, numShards)
+		}
+		return nil
+	}
+
+	for active := len(activeShards(collection)); active < numShards; active = len(activeShards(collection)) {
+		if active+split.numSubShards()-1 > numShards {
+			return fmt.Errorf("cannot reach %d shards from %d by splitting into %d sub-shards", numShards, active, split.numSubShards())
+		}
+
+		err = r.client.SplitShard(ctx, ShardSplitRequest{
+			Collection:   name,
+			Shard:        widestActiveShard(collection),
+			NumSubShards: split.numSubShards(),
+			SplitMethod:  split.SplitMethod.ValueString(),
+		})
+		if err != nil {
+			return err
+		}
+
+		collection, err = r.client.GetCollectionStatus(ctx, name)
+		if err != nil {
+			return err
+		}
+		if len(activeShards(collection)) <= active {
+			return fmt.Errorf("split did not increase the number of active shards beyond %d", active)
+		}
+	}
+
+	return nil
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *collectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state CollectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, state.Timeouts, "delete", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteCollection(ctx, state.Name.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error deleting collection", "Could not delete collection, unexpected error: ", err)
+		return
+	}
 }
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsCollectionNotFound(t *testing.T) {
+	for msg, want := range map[string]bool{
+		"Collection: films not found":                       true,
+		"Could not find collection : films":                 true,
+		"collection already exists: films":                  false,
+		"Can not find the specified config set: films_conf": false,
+	} {
+		assert.Equal(t, want, isCollectionNotFound(fmt.Errorf("wrapped: %w", &SolrError{StatusCode: 400, Msg: msg})), msg)
+	}
+	assert.False(t, isCollectionNotFound(fmt.Errorf("Collection: films not found")))
+	assert.False(t, isCollectionNotFound(nil))
+}
+
+func TestCollectionResourceReadRemovesMissingCollection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/solr/admin/info/system":
+			fmt.Fprint(w, `{"lucene":{"solr-spec-version":"9.4.0"}}`)
+		case "/api/collections/films":
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"responseHeader":{"status":400},"error":{"msg":"Collection: films not found","code":400}}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{Host: server.URL})
+	require.NoError(t, err)
+	r := &collectionResource{client: *client}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw: testObject(objectType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "films"),
+		}),
+	}
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull())
+}
+
+func TestCollectionResourceImportState(t *testing.T) {
+	r := &collectionResource{}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	resp := &resource.ImportStateResponse{State: tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    testObject(objectType, nil),
+	}}
+	r.ImportState(context.Background(), resource.ImportStateRequest{ID: "films"}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var name types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("name"), &name)...)
+	assert.Equal(t, "films", name.ValueString())
+}
+
+// TestCollectionResourceReadHealth checks the health attributes Read derives
+// from the replicas of the active shards.
+func TestCollectionResourceReadHealth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/solr/admin/info/system":
+			fmt.Fprint(w, `{"lucene":{"solr-spec-version":"9.4.0"}}`)
+		case "/api/collections/films":
+			fmt.Fprint(w, `{"cluster":{"collections":{"films":{
+				"health":"YELLOW",
+				"replicationFactor":2,
+				"router":{"name":"compositeId"},
+				"shards":{
+					"shard1":{"state":"inactive","replicas":{
+						"core_node1":{"state":"active","leader":"true"}
+					}},
+					"shard1_0":{"state":"active","replicas":{
+						"core_node5":{"state":"active","leader":"true"},
+						"core_node6":{"state":"recovering"}
+					}},
+					"shard1_1":{"state":"active","replicas":{
+						"core_node7":{"state":"down"},
+						"core_node8":{"state":"active","leader":"true"}
+					}}
+				}
+			}}}}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{Host: server.URL})
+	require.NoError(t, err)
+	r := &collectionResource{client: *client}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw: testObject(objectType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "films"),
+		}),
+	}
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var refreshed CollectionResourceModel
+	require.False(t, resp.State.Get(context.Background(), &refreshed).HasError())
+	assert.Equal(t, "YELLOW", refreshed.Health.ValueString())
+	assert.Equal(t, int64(2), refreshed.ActiveReplicas.ValueInt64())
+	assert.Equal(t, int64(2), refreshed.DownReplicas.ValueInt64())
+	assert.Equal(t, `{"shard1_0":"core_node5","shard1_1":"core_node8"}`, refreshed.Leaders.String())
+	assert.Equal(t, int64(2), refreshed.NumShards.ValueInt64())
+}
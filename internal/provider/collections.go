@@ -1,12 +1,12 @@
 package provider
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -26,37 +26,30 @@ type SolrResponseCollectionList struct {
 	Collections []string `json:"collections"`
 }
 
-func (c *Client) GetCollections() (SolrResponseCollectionList, error) {
+func (c *Client) GetCollections(ctx context.Context) (SolrResponseCollectionList, error) {
 	var response SolrResponseCollectionList
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/solr/admin/collections?action=LIST", c.HostURL), nil)
-	if err != nil {
-		return response, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return response, err
-	}
-
-	err = json.Unmarshal(body, &response)
-	if err != nil {
-		return response, err
-	}
-
-	return response, nil
+	err := c.getJSON(ctx, fmt.Sprintf("%s/solr/admin/collections?action=LIST", c.HostURL), &response)
+	return response, err
 }
 
 // CollectionCreationRequest represents the JSON payload for creating a collection.
 type CollectionCreationRequest struct {
-	Name              string   `json:"name"`
-	NumShards         int      `json:"numShards,omitempty"`
-	ReplicationFactor int      `json:"replicationFactor,omitempty"`
-	Shards            []string `json:"shards,omitempty"`
+	Name              string      `json:"name"`
+	NumShards         int         `json:"numShards,omitempty"`
+	ReplicationFactor int         `json:"replicationFactor,omitempty"`
+	Shards            []string    `json:"shardNames,omitempty"`
+	Router            *RouterInfo `json:"router,omitempty"`
+	Async             string      `json:"async,omitempty"`
 }
 
 // CreateCollection sends a request to SolrCloud to create a new collection.
-// return resp and error
-func (c *Client) CreateCollection(ctx context.Context, name string, numShards int, replicationFactor int, shards []string) (http.Response, error) {
+// Clusters older than Solr 9.3 do not accept this v2 request body and are
+// sent a v1 CREATE call instead.
+func (c *Client) CreateCollection(ctx context.Context, name string, numShards int, replicationFactor int, shards []string, router string) error {
+	if !c.useV2API(ctx, solrVersionV2CreateCollection) {
+		return c.createCollectionV1(ctx, name, numShards, replicationFactor, shards, router)
+	}
+
 	// Construct the request payload
 	// remove nil values from shards
 	requestData := CollectionCreationRequest{
@@ -65,43 +58,67 @@ func (c *Client) CreateCollection(ctx context.Context, name string, numShards in
 		ReplicationFactor: replicationFactor,
 		Shards:            shards,
 	}
+	if router != "" {
+		requestData.Router = &RouterInfo{Name: router}
+	}
+
+	// The async id makes the request safe to retry: Solr refuses to run
+	// a second request with the same id.
+	requestData.Async = newAsyncID("CREATE")
 
 	// tflog
 	tflog.Info(ctx, fmt.Sprintf("Creating collection: %s", name))
 
-	jsonData, err := json.Marshal(requestData)
-	if err != nil {
-		return http.Response{}, fmt.Errorf("error marshalling request data: %w", err)
+	err := c.sendJSON(withRetrySafe(ctx), "POST", fmt.Sprintf("%s/api/collections", c.HostURL), requestData, nil)
+	if err != nil && !isDuplicateAsyncID(err) {
+		return fmt.Errorf("error creating collection: %w", err)
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Request data: %s", jsonData))
-
-	// Create the request
-	url := fmt.Sprintf("%s/api/collections", c.HostURL)
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonData))
-	if err != nil {
-		return http.Response{}, fmt.Errorf("error creating request: %w", err)
+	if err := c.WaitForAsync(ctx, requestData.Async); err != nil {
+		return fmt.Errorf("error creating collection: %w", err)
 	}
-	req.Header.Set("Content-Type", "application/json")
 
-	// Execute the request
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return http.Response{}, fmt.Errorf("error executing request: %w", err)
+	return nil
+}
+
+// DeleteCollection deletes a collection with an async DELETE call. Deleting
+// a collection that does not exist is not an error.
+func (c *Client) DeleteCollection(ctx context.Context, name string) error {
+	tflog.Info(ctx, fmt.Sprintf("Deleting collection: %s", name))
+
+	err := c.collectionsAPIAsync(ctx, "DELETE", url.Values{"name": {name}})
+	if err != nil && !isCollectionNotFound(err) {
+		return fmt.Errorf("error deleting collection: %w", err)
 	}
 
-	// Read the response body
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return http.Response{}, fmt.Errorf("error reading response body: %w", err)
+	return nil
+}
+
+// createCollectionV1 creates a collection with the v1 CREATE action.
+func (c *Client) createCollectionV1(ctx context.Context, name string, numShards int, replicationFactor int, shards []string, router string) error {
+	params := url.Values{}
+	params.Set("name", name)
+	if numShards > 0 {
+		params.Set("numShards", strconv.Itoa(numShards))
+	}
+	if replicationFactor > 0 {
+		params.Set("replicationFactor", strconv.Itoa(replicationFactor))
+	}
+	if len(shards) > 0 {
+		params.Set("shards", strings.Join(shards, ","))
+	}
+	if router != "" {
+		params.Set("router.name", router)
 	}
 
-	// Check the response status code
-	if resp.StatusCode != http.StatusOK {
-		return http.Response{}, fmt.Errorf("error creating collection: %s", body)
+	tflog.Info(ctx, fmt.Sprintf("Creating collection: %s", name))
+
+	err := c.collectionsAPIAsync(ctx, "CREATE", params)
+	if err != nil {
+		return fmt.Errorf("error creating collection: %w", err)
 	}
 
-	return *resp, nil
+	return nil
 }
 
 type CollectionStatusResponse struct {
@@ -145,6 +162,10 @@ type ResponseHeader struct {
 type ClusterInfo struct {
 	Collections map[string]CollectionInfo `json:"collections"`
 	LiveNodes   []string                  `json:"live_nodes"`
+	// Aliases maps each alias to its comma-separated target collections.
+	Aliases map[string]string `json:"aliases"`
+	// Roles maps legacy ADDROLE roles such as overseer to their nodes.
+	Roles map[string][]string `json:"roles"`
 }
 
 type CollectionInfo struct {
@@ -157,6 +178,7 @@ type CollectionInfo struct {
 	Shards            map[string]ShardInfo `json:"shards"`
 	Health            string               `json:"health"`
 	ZnodeVersion      int                  `json:"znodeVersion"`
+	Aliases           []string             `json:"aliases"`
 }
 
 type RouterInfo struct {
@@ -178,34 +200,68 @@ type ReplicaInfo struct {
 	Leader        string `json:"leader"`
 	ForceSetState string `json:"force_set_state"`
 	BaseURL       string `json:"base_url"`
+	// PreferredLeader is "true" when the preferredLeader property is set.
+	PreferredLeader string `json:"property.preferredleader"`
 }
 
-func (c *Client) GetCollectionStatus(collectionName string) (CollectionInfo, error) {
-	var collectionStatus CollectionStatusResponse2
+// GetCollectionStatus returns the CLUSTERSTATUS entry of a collection. The v2
+// collection endpoint is used on Solr 8 and newer.
+func (c *Client) GetCollectionStatus(ctx context.Context, collectionName string) (CollectionInfo, error) {
+	if !c.useV2API(ctx, solrVersionV2CollectionStatus) {
+		cluster, err := c.GetClusterStatus(ctx, ClusterStatusFilter{Collection: collectionName})
+		if err != nil {
+			return CollectionInfo{}, err
+		}
+		return cluster.Collections[collectionName], nil
+	}
 
-	// Construct the URL for the collection status API
-	url := fmt.Sprintf("%s/api/collections/%s", c.HostURL, collectionName)
+	var collectionStatus CollectionStatusResponse2
 
-	// Create an HTTP GET request
-	req, err := http.NewRequest("GET", url, nil)
+	err := c.getJSON(ctx, fmt.Sprintf("%s/api/collections/%s", c.HostURL, collectionName), &collectionStatus)
 	if err != nil {
-		return CollectionInfo{}, fmt.Errorf("error creating request: %w", err)
+		return CollectionInfo{}, err
 	}
 
-	// Perform the HTTP request
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return CollectionInfo{}, fmt.Errorf("error executing request: %w", err)
+	return collectionStatus.Cluster.Collections[collectionName], nil
+}
+
+// ClusterStatusFilter narrows a CLUSTERSTATUS call to a collection, its
+// shards or the shard a route key maps to. Empty fields are not sent.
+type ClusterStatusFilter struct {
+	Collection string
+	Shard      string
+	Route      string
+}
+
+// GetClusterStatus returns the CLUSTERSTATUS view of the cluster's
+// collections and its live nodes.
+func (c *Client) GetClusterStatus(ctx context.Context, filter ClusterStatusFilter) (ClusterInfo, error) {
+	var response CollectionStatusResponse2
+
+	params := url.Values{}
+	if filter.Collection != "" {
+		params.Set("collection", filter.Collection)
+	}
+	if filter.Shard != "" {
+		params.Set("shard", filter.Shard)
+	}
+	if filter.Route != "" {
+		params.Set("_route_", filter.Route)
 	}
-	defer resp.Body.Close()
 
-	// Read and unmarshal the response
-	body, err := ioutil.ReadAll(resp.Body)
+	body, err := c.collectionsAPI(ctx, "CLUSTERSTATUS", params)
+	if err != nil {
+		return ClusterInfo{}, err
+	}
 
-	err = json.Unmarshal(body, &collectionStatus)
+	err = json.Unmarshal(body, &response)
 	if err != nil {
-		return CollectionInfo{}, fmt.Errorf("error unmarshalling response: %w", err)
+		return ClusterInfo{}, fmt.Errorf("error unmarshalling response: %w", err)
 	}
 
-	return collectionStatus.Cluster.Collections[collectionName], nil
+	if c.hosts != nil {
+		c.hosts.setLiveNodes(response.Cluster.LiveNodes)
+	}
+
+	return response.Cluster, nil
 }
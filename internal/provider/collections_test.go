@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteCollection(t *testing.T) {
+	var actions []string
+	missing := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		action := r.URL.Query().Get("action")
+		actions = append(actions, action)
+		switch action {
+		case "DELETE":
+			if missing {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"responseHeader":{"status":400},"error":{"msg":"Could not find collection : films","code":400}}`)
+				return
+			}
+			assert.Equal(t, "films", r.URL.Query().Get("name"))
+			assert.NotEmpty(t, r.URL.Query().Get("async"))
+		case "REQUESTSTATUS":
+			fmt.Fprint(w, `{"status":{"state":"completed"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"responseHeader":{"status":0}}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{Host: server.URL})
+	require.NoError(t, err)
+
+	require.NoError(t, client.DeleteCollection(context.Background(), "films"))
+	assert.Equal(t, []string{"DELETE", "REQUESTSTATUS", "DELETESTATUS"}, actions)
+
+	missing = true
+	assert.NoError(t, client.DeleteCollection(context.Background(), "films"))
+}
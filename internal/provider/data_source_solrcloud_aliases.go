@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &aliasesDataSource{}
+	_ datasource.DataSourceWithConfigure = &aliasesDataSource{}
+)
+
+func NewAliasesDataSource() datasource.DataSource {
+	return &aliasesDataSource{}
+}
+
+type aliasesDataSource struct {
+	client Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *aliasesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *solrcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = *client
+}
+
+func (d *aliasesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_aliases"
+}
+
+// Schema defines the schema for the data source.
+func (d *aliasesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists collection aliases with LISTALIASES.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return this alias.",
+			},
+			"aliases": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"collections": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The collections the alias points at, in alias order.",
+						},
+						"properties": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The alias properties, including router.* settings of routed aliases.",
+						},
+						"router_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The router of a routed alias (time or category), empty for plain aliases.",
+						},
+						"router_field": schema.StringAttribute{
+							Computed:    true,
+							Description: "The field a routed alias routes documents on.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type aliasesDataSourceModel struct {
+	Name    types.String `tfsdk:"name"`
+	Aliases []aliasModel `tfsdk:"aliases"`
+}
+
+type aliasModel struct {
+	Name        types.String   `tfsdk:"name"`
+	Collections []types.String `tfsdk:"collections"`
+	Properties  types.Map      `tfsdk:"properties"`
+	RouterName  types.String   `tfsdk:"router_name"`
+	RouterField types.String   `tfsdk:"router_field"`
+}
+
+func (d *aliasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state aliasesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aliases, err := d.client.ListAliases(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to fetch aliases", "Unable to fetch aliases: ", err)
+		return
+	}
+
+	state.Aliases = []aliasModel{}
+	for _, name := range sortedKeys(aliases.Aliases) {
+		if !state.Name.IsNull() && name != state.Name.ValueString() {
+			continue
+		}
+
+		alias, aliasDiags := newAliasModel(ctx, name, aliases.Aliases[name], aliases.Properties[name])
+		resp.Diagnostics.Append(aliasDiags...)
+		state.Aliases = append(state.Aliases, alias)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newAliasModel flattens a LISTALIASES entry.
+func newAliasModel(ctx context.Context, name, targets string, properties map[string]string) (aliasModel, diag.Diagnostics) {
+	if properties == nil {
+		properties = map[string]string{}
+	}
+
+	alias := aliasModel{
+		Name:        types.StringValue(name),
+		Collections: []types.String{},
+		RouterName:  types.StringValue(properties["router.name"]),
+		RouterField: types.StringValue(properties["router.field"]),
+	}
+	for _, collection := range aliasCollections(targets) {
+		alias.Collections = append(alias.Collections, types.StringValue(collection))
+	}
+
+	var diags diag.Diagnostics
+	alias.Properties, diags = types.MapValueFrom(ctx, types.StringType, properties)
+	return alias, diags
+}
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &clusterStatusDataSource{}
+	_ datasource.DataSourceWithConfigure = &clusterStatusDataSource{}
+)
+
+func NewClusterStatusDataSource() datasource.DataSource {
+	return &clusterStatusDataSource{}
+}
+
+type clusterStatusDataSource struct {
+	client Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *clusterStatusDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *solrcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = *client
+}
+
+func (d *clusterStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_status"
+}
+
+// replicaStatusAttributes describes a replica in CLUSTERSTATUS.
+var replicaStatusAttributes = map[string]schema.Attribute{
+	"name": schema.StringAttribute{
+		Computed:    true,
+		Description: "The core_node name of the replica.",
+	},
+	"core": schema.StringAttribute{
+		Computed: true,
+	},
+	"node_name": schema.StringAttribute{
+		Computed: true,
+	},
+	"base_url": schema.StringAttribute{
+		Computed: true,
+	},
+	"type": schema.StringAttribute{
+		Computed: true,
+	},
+	"state": schema.StringAttribute{
+		Computed: true,
+	},
+	"leader": schema.BoolAttribute{
+		Computed: true,
+	},
+}
+
+// shardStatusAttributes describes a shard in CLUSTERSTATUS.
+var shardStatusAttributes = map[string]schema.Attribute{
+	"name": schema.StringAttribute{
+		Computed: true,
+	},
+	"range": schema.StringAttribute{
+		Computed: true,
+	},
+	"state": schema.StringAttribute{
+		Computed: true,
+	},
+	"health": schema.StringAttribute{
+		Computed: true,
+	},
+	"replicas": schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: replicaStatusAttributes,
+		},
+	},
+}
+
+// Schema defines the schema for the data source.
+func (d *clusterStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the cluster topology from CLUSTERSTATUS.",
+		Attributes: map[string]schema.Attribute{
+			"collection": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return this collection.",
+			},
+			"shard": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return these comma-separated shards. Requires collection.",
+			},
+			"route": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the shard the route key maps to. Requires collection.",
+			},
+			"live_nodes": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"collections": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"config_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"router": schema.StringAttribute{
+							Computed: true,
+						},
+						"health": schema.StringAttribute{
+							Computed: true,
+						},
+						"znode_version": schema.Int64Attribute{
+							Computed: true,
+						},
+						"shards": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: shardStatusAttributes,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type clusterStatusDataSourceModel struct {
+	Collection  types.String            `tfsdk:"collection"`
+	Shard       types.String            `tfsdk:"shard"`
+	Route       types.String            `tfsdk:"route"`
+	LiveNodes   []types.String          `tfsdk:"live_nodes"`
+	Collections []collectionStatusModel `tfsdk:"collections"`
+}
+
+type collectionStatusModel struct {
+	Name         types.String       `tfsdk:"name"`
+	ConfigName   types.String       `tfsdk:"config_name"`
+	Router       types.String       `tfsdk:"router"`
+	Health       types.String       `tfsdk:"health"`
+	ZnodeVersion types.Int64        `tfsdk:"znode_version"`
+	Shards       []shardStatusModel `tfsdk:"shards"`
+}
+
+type shardStatusModel struct {
+	Name     types.String         `tfsdk:"name"`
+	Range    types.String         `tfsdk:"range"`
+	State    types.String         `tfsdk:"state"`
+	Health   types.String         `tfsdk:"health"`
+	Replicas []replicaStatusModel `tfsdk:"replicas"`
+}
+
+type replicaStatusModel struct {
+	Name     types.String `tfsdk:"name"`
+	Core     types.String `tfsdk:"core"`
+	NodeName types.String `tfsdk:"node_name"`
+	BaseURL  types.String `tfsdk:"base_url"`
+	Type     types.String `tfsdk:"type"`
+	State    types.String `tfsdk:"state"`
+	Leader   types.Bool   `tfsdk:"leader"`
+}
+
+func (d *clusterStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state clusterStatusDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Collection.IsNull() && (!state.Shard.IsNull() || !state.Route.IsNull()) {
+		resp.Diagnostics.AddError(
+			"Missing collection filter",
+			"The shard and route filters can only be used together with the collection filter.",
+		)
+		return
+	}
+
+	cluster, err := d.client.GetClusterStatus(ctx, ClusterStatusFilter{
+		Collection: state.Collection.ValueString(),
+		Shard:      state.Shard.ValueString(),
+		Route:      state.Route.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to fetch cluster status", "Unable to fetch cluster status: ", err)
+		return
+	}
+
+	state.LiveNodes = []types.String{}
+	for _, node := range cluster.LiveNodes {
+		state.LiveNodes = append(state.LiveNodes, types.StringValue(node))
+	}
+
+	state.Collections = []collectionStatusModel{}
+	for _, name := range sortedKeys(cluster.Collections) {
+		state.Collections = append(state.Collections, newCollectionStatusModel(name, cluster.Collections[name]))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newCollectionStatusModel flattens a collection's CLUSTERSTATUS entry.
+func newCollectionStatusModel(name string, collection CollectionInfo) collectionStatusModel {
+	model := collectionStatusModel{
+		Name:         types.StringValue(name),
+		ConfigName:   types.StringValue(collection.ConfigName),
+		Router:       types.StringValue(collection.Router.Name),
+		Health:       types.StringValue(collection.Health),
+		ZnodeVersion: types.Int64Value(int64(collection.ZnodeVersion)),
+		Shards:       newShardStatusModels(collection),
+	}
+	return model
+}
+
+// newShardStatusModels flattens the shards of a collection in name order.
+func newShardStatusModels(collection CollectionInfo) []shardStatusModel {
+	shards := []shardStatusModel{}
+	for _, shardName := range sortedKeys(collection.Shards) {
+		shard := collection.Shards[shardName]
+		replicas := []replicaStatusModel{}
+		for _, replicaName := range sortedKeys(shard.Replicas) {
+			replica := shard.Replicas[replicaName]
+			replicas = append(replicas, replicaStatusModel{
+				Name:     types.StringValue(replicaName),
+				Core:     types.StringValue(replica.Core),
+				NodeName: types.StringValue(replica.NodeName),
+				BaseURL:  types.StringValue(replica.BaseURL),
+				Type:     types.StringValue(replica.Type),
+				State:    types.StringValue(replica.State),
+				Leader:   types.BoolValue(replica.Leader == "true"),
+			})
+		}
+		shards = append(shards, shardStatusModel{
+			Name:     types.StringValue(shardName),
+			Range:    types.StringValue(shard.Range),
+			State:    types.StringValue(shard.State),
+			Health:   types.StringValue(shard.Health),
+			Replicas: replicas,
+		})
+	}
+	return shards
+}
+
+// sortedKeys returns the keys of a map in ascending order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testClusterStatusServer answers CLUSTERSTATUS with a two node cluster and
+// stores the parameters of the last call in params.
+func testClusterStatusServer(t *testing.T, params *url.Values) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("action") != "CLUSTERSTATUS" {
+			t.Errorf("unexpected request %s", r.URL)
+			return
+		}
+		*params = r.URL.Query()
+		fmt.Fprint(w, `{"cluster":{
+			"live_nodes":["10.0.0.1:8983_solr","10.0.0.2:8983_solr"],
+			"collections":{"films":{
+				"configName":"films_conf",
+				"router":{"name":"compositeId"},
+				"health":"GREEN",
+				"znodeVersion":12,
+				"shards":{
+					"shard2":{"range":"0-7fffffff","state":"active","health":"GREEN","replicas":{
+						"core_node4":{"core":"films_shard2_replica_n3","node_name":"10.0.0.2:8983_solr","base_url":"http://10.0.0.2:8983/solr","type":"NRT","state":"active","leader":"true"}
+					}},
+					"shard1":{"range":"80000000-ffffffff","state":"active","health":"GREEN","replicas":{
+						"core_node2":{"core":"films_shard1_replica_n1","node_name":"10.0.0.1:8983_solr","base_url":"http://10.0.0.1:8983/solr","type":"NRT","state":"active","leader":"true"},
+						"core_node6":{"core":"films_shard1_replica_t5","node_name":"10.0.0.2:8983_solr","base_url":"http://10.0.0.2:8983/solr","type":"TLOG","state":"recovering"}
+					}}
+				}
+			}}
+		}}`)
+	}))
+}
+
+func TestGetClusterStatusFilter(t *testing.T) {
+	var params url.Values
+	server := testClusterStatusServer(t, &params)
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{Host: server.URL})
+	require.NoError(t, err)
+
+	_, err = client.GetClusterStatus(context.Background(), ClusterStatusFilter{})
+	require.NoError(t, err)
+	for _, name := range []string{"collection", "shard", "_route_"} {
+		assert.False(t, params.Has(name), name)
+	}
+
+	_, err = client.GetClusterStatus(context.Background(), ClusterStatusFilter{Collection: "films", Shard: "shard1,shard2", Route: "user42!"})
+	require.NoError(t, err)
+	assert.Equal(t, "films", params.Get("collection"))
+	assert.Equal(t, "shard1,shard2", params.Get("shard"))
+	assert.Equal(t, "user42!", params.Get("_route_"))
+}
+
+func TestClusterStatusDataSource(t *testing.T) {
+	var params url.Values
+	server := testClusterStatusServer(t, &params)
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{Host: server.URL})
+	require.NoError(t, err)
+
+	resp := readDataSource(t, &clusterStatusDataSource{client: *client}, map[string]tftypes.Value{
+		"collection": tftypes.NewValue(tftypes.String, "films"),
+		"route":      tftypes.NewValue(tftypes.String, "user42!"),
+	})
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, "films", params.Get("collection"))
+	assert.Equal(t, "user42!", params.Get("_route_"))
+
+	var state clusterStatusDataSourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	assert.Equal(t, []string{"10.0.0.1:8983_solr", "10.0.0.2:8983_solr"}, stringValues(state.LiveNodes))
+
+	require.Len(t, state.Collections, 1)
+	films := state.Collections[0]
+	assert.Equal(t, "films", films.Name.ValueString())
+	assert.Equal(t, "films_conf", films.ConfigName.ValueString())
+	assert.Equal(t, "compositeId", films.Router.ValueString())
+	assert.Equal(t, "GREEN", films.Health.ValueString())
+	assert.Equal(t, int64(12), films.ZnodeVersion.ValueInt64())
+
+	require.Len(t, films.Shards, 2)
+	shard1 := films.Shards[0]
+	assert.Equal(t, "shard1", shard1.Name.ValueString())
+	assert.Equal(t, "80000000-ffffffff", shard1.Range.ValueString())
+	require.Len(t, shard1.Replicas, 2)
+	assert.Equal(t, "core_node2", shard1.Replicas[0].Name.ValueString())
+	assert.Equal(t, "films_shard1_replica_n1", shard1.Replicas[0].Core.ValueString())
+	assert.Equal(t, "10.0.0.1:8983_solr", shard1.Replicas[0].NodeName.ValueString())
+	assert.Equal(t, "http://10.0.0.1:8983/solr", shard1.Replicas[0].BaseURL.ValueString())
+	assert.True(t, shard1.Replicas[0].Leader.ValueBool())
+	assert.Equal(t, "TLOG", shard1.Replicas[1].Type.ValueString())
+	assert.Equal(t, "recovering", shard1.Replicas[1].State.ValueString())
+	assert.False(t, shard1.Replicas[1].Leader.ValueBool())
+	assert.Equal(t, "shard2", films.Shards[1].Name.ValueString())
+}
+
+func TestClusterStatusDataSourceRequiresCollection(t *testing.T) {
+	resp := readDataSource(t, &clusterStatusDataSource{}, map[string]tftypes.Value{
+		"shard": tftypes.NewValue(tftypes.String, "shard1"),
+	})
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Missing collection filter", resp.Diagnostics.Errors()[0].Summary())
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &collectionDataSource{}
+	_ datasource.DataSourceWithConfigure = &collectionDataSource{}
+)
+
+func NewCollectionDataSource() datasource.DataSource {
+	return &collectionDataSource{}
+}
+
+type collectionDataSource struct {
+	client Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *collectionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *solrcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = *client
+}
+
+func (d *collectionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collection"
+}
+
+// Schema defines the schema for the data source.
+func (d *collectionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the configuration and topology of a single collection.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the collection.",
+			},
+			"config_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"router": schema.StringAttribute{
+				Computed: true,
+			},
+			"num_shards": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of active shards.",
+			},
+			"replication_factor": schema.Int64Attribute{
+				Computed: true,
+			},
+			"nrt_replicas": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of NRT replicas across all active shards.",
+			},
+			"tlog_replicas": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of TLOG replicas across all active shards.",
+			},
+			"pull_replicas": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of PULL replicas across all active shards.",
+			},
+			"znode_version": schema.Int64Attribute{
+				Computed: true,
+			},
+			"health": schema.StringAttribute{
+				Computed: true,
+			},
+			"aliases": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The aliases that point at the collection.",
+			},
+			"shards": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: shardStatusAttributes,
+				},
+			},
+		},
+	}
+}
+
+type collectionDataSourceModel struct {
+	Name              types.String       `tfsdk:"name"`
+	ConfigName        types.String       `tfsdk:"config_name"`
+	Router            types.String       `tfsdk:"router"`
+	NumShards         types.Int64        `tfsdk:"num_shards"`
+	ReplicationFactor types.Int64        `tfsdk:"replication_factor"`
+	NrtReplicas       types.Int64        `tfsdk:"nrt_replicas"`
+	TlogReplicas      types.Int64        `tfsdk:"tlog_replicas"`
+	PullReplicas      types.Int64        `tfsdk:"pull_replicas"`
+	ZnodeVersion      types.Int64        `tfsdk:"znode_version"`
+	Health            types.String       `tfsdk:"health"`
+	Aliases           []types.String     `tfsdk:"aliases"`
+	Shards            []shardStatusModel `tfsdk:"shards"`
+}
+
+func (d *collectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state collectionDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	cluster, err := d.client.GetClusterStatus(ctx, ClusterStatusFilter{Collection: name})
+	if err != nil && !isCollectionNotFound(err) {
+		addClientError(&resp.Diagnostics, "Unable to read collection", fmt.Sprintf("Unable to read collection %s: ", name), err)
+		return
+	}
+
+	// CLUSTERSTATUS fails for a collection that does not exist.
+	collection, ok := cluster.Collections[name]
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Collection not found",
+			fmt.Sprintf("The collection %s does not exist in the cluster.", name),
+		)
+		return
+	}
+
+	counts := replicaTypeCounts(collection)
+	state.ConfigName = types.StringValue(collection.ConfigName)
+	state.Router = types.StringValue(collection.Router.Name)
+	state.NumShards = types.Int64Value(int64(len(activeShards(collection))))
+	state.ReplicationFactor = types.Int64Value(int64(collection.ReplicationFactor))
+	state.NrtReplicas = types.Int64Value(int64(counts["nrt"]))
+	state.TlogReplicas = types.Int64Value(int64(counts["tlog"]))
+	state.PullReplicas = types.Int64Value(int64(counts["pull"]))
+	state.ZnodeVersion = types.Int64Value(int64(collection.ZnodeVersion))
+	state.Health = types.StringValue(collection.Health)
+	state.Shards = newShardStatusModels(collection)
+
+	state.Aliases = []types.String{}
+	for _, alias := range collectionAliases(cluster, name) {
+		state.Aliases = append(state.Aliases, types.StringValue(alias))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// replicaTypeCounts counts the replicas of the active shards by lower case type.
+func replicaTypeCounts(collection CollectionInfo) map[string]int {
+	counts := map[string]int{}
+	for _, shard := range activeShards(collection) {
+		for _, replica := range collection.Shards[shard].Replicas {
+			counts[replicaType(replica.Type)]++
+		}
+	}
+	return counts
+}
+
+// collectionAliases returns the aliases pointing at a collection, preferring
+// the per-collection list CLUSTERSTATUS reports on newer Solr versions.
+func collectionAliases(cluster ClusterInfo, name string) []string {
+	if aliases := cluster.Collections[name].Aliases; len(aliases) > 0 {
+		return aliases
+	}
+
+	var aliases []string
+	for _, alias := range sortedKeys(cluster.Aliases) {
+		for _, target := range aliasCollections(cluster.Aliases[alias]) {
+			if target == name {
+				aliases = append(aliases, alias)
+			}
+		}
+	}
+	return aliases
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &collectionBackupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &collectionBackupsDataSource{}
+)
+
+func NewCollectionBackupsDataSource() datasource.DataSource {
+	return &collectionBackupsDataSource{}
+}
+
+type collectionBackupsDataSource struct {
+	client Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *collectionBackupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *solrcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = *client
+}
+
+func (d *collectionBackupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collection_backups"
+}
+
+// Schema defines the schema for the data source.
+func (d *collectionBackupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the incremental backup points stored under a backup name using LISTBACKUP.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the backup.",
+			},
+			"location": schema.StringAttribute{
+				Optional:    true,
+				Description: "The location in the backup repository.",
+			},
+			"repository": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the backup repository.",
+			},
+			"collection": schema.StringAttribute{
+				Computed:    true,
+				Description: "The collection the backup was taken from.",
+			},
+			"backups": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"backup_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"config_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"index_version": schema.StringAttribute{
+							Computed: true,
+						},
+						"start_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"end_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"index_file_count": schema.Int64Attribute{
+							Computed: true,
+						},
+						"index_size_mb": schema.Float64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type collectionBackupsDataSourceModel struct {
+	Name       types.String       `tfsdk:"name"`
+	Location   types.String       `tfsdk:"location"`
+	Repository types.String       `tfsdk:"repository"`
+	Collection types.String       `tfsdk:"collection"`
+	Backups    []backupPointModel `tfsdk:"backups"`
+}
+
+type backupPointModel struct {
+	BackupID       types.Int64   `tfsdk:"backup_id"`
+	ConfigName     types.String  `tfsdk:"config_name"`
+	IndexVersion   types.String  `tfsdk:"index_version"`
+	StartTime      types.String  `tfsdk:"start_time"`
+	EndTime        types.String  `tfsdk:"end_time"`
+	IndexFileCount types.Int64   `tfsdk:"index_file_count"`
+	IndexSizeMB    types.Float64 `tfsdk:"index_size_mb"`
+}
+
+func (d *collectionBackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state collectionBackupsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backups, err := d.client.ListBackups(ctx, state.Name.ValueString(), state.Location.ValueString(), state.Repository.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to list backups", "Unable to list backups: ", err)
+		return
+	}
+
+	state.Collection = types.StringValue(backups.Collection)
+	state.Backups = []backupPointModel{}
+	for _, backup := range backups.Backups {
+		state.Backups = append(state.Backups, backupPointModel{
+			BackupID:       types.Int64Value(int64(backup.BackupID)),
+			ConfigName:     types.StringValue(backup.ConfigName),
+			IndexVersion:   types.StringValue(backup.IndexVersion),
+			StartTime:      types.StringValue(backup.StartTime),
+			EndTime:        types.StringValue(backup.EndTime),
+			IndexFileCount: types.Int64Value(int64(backup.IndexFileCount)),
+			IndexSizeMB:    types.Float64Value(backup.IndexSizeMB),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
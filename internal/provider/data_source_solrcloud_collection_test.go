@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectionDataSourceNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "CLUSTERSTATUS", r.URL.Query().Get("action"))
+		assert.Equal(t, "films", r.URL.Query().Get("collection"))
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"responseHeader":{"status":400},"error":{"msg":"Collection: films not found","code":400}}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{Host: server.URL})
+	require.NoError(t, err)
+
+	resp := readDataSource(t, &collectionDataSource{client: *client}, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "films"),
+	})
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Collection not found", resp.Diagnostics.Errors()[0].Summary())
+}
@@ -3,9 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -49,35 +51,155 @@ func (d *collectionsDataSource) Metadata(_ context.Context, req datasource.Metad
 func (d *collectionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return collections whose name matches this regular expression.",
+			},
+			"config_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return collections using this configset.",
+			},
+			"alias": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return collections this alias points at. Reading fails if the alias does not exist.",
+			},
+			"details": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Also populate collection_details with the configset, health and shard count of each collection.",
+			},
 			"collections": schema.ListAttribute{
 				ElementType: types.StringType,
 				Computed:    true,
 			},
+			"collection_details": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching collections with their details. Only populated when details is true.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"config_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"health": schema.StringAttribute{
+							Computed: true,
+						},
+						"num_shards": schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 type collectionsDataSourceModel struct {
-	Collections []types.String `tfsdk:"collections"`
+	NameRegex         types.String             `tfsdk:"name_regex"`
+	ConfigName        types.String             `tfsdk:"config_name"`
+	Alias             types.String             `tfsdk:"alias"`
+	Details           types.Bool               `tfsdk:"details"`
+	Collections       []types.String           `tfsdk:"collections"`
+	CollectionDetails []collectionDetailsModel `tfsdk:"collection_details"`
+}
+
+type collectionDetailsModel struct {
+	Name       types.String `tfsdk:"name"`
+	ConfigName types.String `tfsdk:"config_name"`
+	Health     types.String `tfsdk:"health"`
+	NumShards  types.Int64  `tfsdk:"num_shards"`
 }
 
 func (d *collectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state collectionsDataSourceModel
-
-	collections, err := d.client.GetCollections()
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to fetch collections",
-			fmt.Sprintf("Unable to fetch collections: %s", err),
-		)
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	for _, collectionName := range collections.Collections {
-		state.Collections = append(state.Collections, types.StringValue(collectionName))
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid name_regex",
+				fmt.Sprintf("Unable to compile name_regex: %s", err),
+			)
+			return
+		}
+	}
+
+	state.Collections = []types.String{}
+	state.CollectionDetails = []collectionDetailsModel{}
+
+	// The LIST action is enough when only filtering by name.
+	if state.ConfigName.IsNull() && state.Alias.IsNull() && !state.Details.ValueBool() {
+		collections, err := d.client.GetCollections(ctx)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Unable to fetch collections", "Unable to fetch collections: ", err)
+			return
+		}
+
+		for _, collectionName := range collections.Collections {
+			if nameRegex != nil && !nameRegex.MatchString(collectionName) {
+				continue
+			}
+			state.Collections = append(state.Collections, types.StringValue(collectionName))
+		}
+	} else {
+		cluster, err := d.client.GetClusterStatus(ctx, ClusterStatusFilter{})
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Unable to fetch collections", "Unable to fetch collections: ", err)
+			return
+		}
+
+		var aliasTargets map[string]bool
+		if !state.Alias.IsNull() {
+			targets, ok := cluster.Aliases[state.Alias.ValueString()]
+			if !ok {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("alias"),
+					"Alias not found",
+					fmt.Sprintf("The alias %s does not exist.", state.Alias.ValueString()),
+				)
+				return
+			}
+
+			aliasTargets = map[string]bool{}
+			for _, target := range aliasCollections(targets) {
+				aliasTargets[target] = true
+			}
+		}
+
+		for _, collectionName := range sortedKeys(cluster.Collections) {
+			collection := cluster.Collections[collectionName]
+			if nameRegex != nil && !nameRegex.MatchString(collectionName) {
+				continue
+			}
+			if !state.ConfigName.IsNull() && collection.ConfigName != state.ConfigName.ValueString() {
+				continue
+			}
+			if aliasTargets != nil && !aliasTargets[collectionName] {
+				continue
+			}
+
+			state.Collections = append(state.Collections, types.StringValue(collectionName))
+			if state.Details.ValueBool() {
+				state.CollectionDetails = append(state.CollectionDetails, collectionDetailsModel{
+					Name:       types.StringValue(collectionName),
+					ConfigName: types.StringValue(collection.ConfigName),
+					Health:     types.StringValue(collection.Health),
+					NumShards:  types.Int64Value(int64(len(activeShards(collection)))),
+				})
+			}
+		}
 	}
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readDataSource runs Read on d with the given configuration attributes;
+// unset attributes are null.
+func readDataSource(t *testing.T, d datasource.DataSource, attributes map[string]tftypes.Value) *datasource.ReadResponse {
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    testObject(objectType, nil),
+	}}
+	d.Read(context.Background(), datasource.ReadRequest{Config: tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    testObject(objectType, attributes),
+	}}, resp)
+	return resp
+}
+
+// testCollectionsServer answers LIST and CLUSTERSTATUS for a cluster with the
+// collections films, films_v2 and books, and the alias movies for films_v2.
+func testCollectionsServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("action") {
+		case "LIST":
+			fmt.Fprint(w, `{"collections":["films","films_v2","books"]}`)
+		case "CLUSTERSTATUS":
+			fmt.Fprint(w, `{"cluster":{
+				"collections":{
+					"films":{"configName":"films_conf","health":"GREEN","shards":{"shard1":{"state":"active"},"shard2":{"state":"active"}}},
+					"films_v2":{"configName":"films_conf","health":"YELLOW","shards":{"shard1":{"state":"active"}}},
+					"books":{"configName":"_default","health":"GREEN","shards":{"shard1":{"state":"active"}}}
+				},
+				"aliases":{"movies":"films_v2"},
+				"live_nodes":[]
+			}}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+		}
+	}))
+}
+
+func TestCollectionsDataSourceFilters(t *testing.T) {
+	server := testCollectionsServer(t)
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{Host: server.URL})
+	require.NoError(t, err)
+
+	for name, test := range map[string]struct {
+		attributes map[string]tftypes.Value
+		want       []string
+	}{
+		"all": {
+			want: []string{"films", "films_v2", "books"},
+		},
+		"name_regex": {
+			attributes: map[string]tftypes.Value{"name_regex": tftypes.NewValue(tftypes.String, "^films")},
+			want:       []string{"films", "films_v2"},
+		},
+		"config_name": {
+			attributes: map[string]tftypes.Value{"config_name": tftypes.NewValue(tftypes.String, "_default")},
+			want:       []string{"books"},
+		},
+		"alias": {
+			attributes: map[string]tftypes.Value{"alias": tftypes.NewValue(tftypes.String, "movies")},
+			want:       []string{"films_v2"},
+		},
+		"no match": {
+			attributes: map[string]tftypes.Value{"name_regex": tftypes.NewValue(tftypes.String, "^music")},
+		},
+	} {
+		resp := readDataSource(t, &collectionsDataSource{client: *client}, test.attributes)
+		require.False(t, resp.Diagnostics.HasError(), name, resp.Diagnostics)
+
+		var state collectionsDataSourceModel
+		require.False(t, resp.State.Get(context.Background(), &state).HasError(), name)
+		assert.Equal(t, test.want, stringValues(state.Collections), name)
+		assert.NotNil(t, state.Collections, name)
+		assert.Equal(t, []collectionDetailsModel{}, state.CollectionDetails, name)
+	}
+}
+
+func TestCollectionsDataSourceDetails(t *testing.T) {
+	server := testCollectionsServer(t)
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{Host: server.URL})
+	require.NoError(t, err)
+
+	resp := readDataSource(t, &collectionsDataSource{client: *client}, map[string]tftypes.Value{
+		"name_regex": tftypes.NewValue(tftypes.String, "^films"),
+		"details":    tftypes.NewValue(tftypes.Bool, true),
+	})
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var state collectionsDataSourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	require.Len(t, state.CollectionDetails, 2)
+	assert.Equal(t, "films", state.CollectionDetails[0].Name.ValueString())
+	assert.Equal(t, "films_conf", state.CollectionDetails[0].ConfigName.ValueString())
+	assert.Equal(t, "GREEN", state.CollectionDetails[0].Health.ValueString())
+	assert.Equal(t, int64(2), state.CollectionDetails[0].NumShards.ValueInt64())
+	assert.Equal(t, "films_v2", state.CollectionDetails[1].Name.ValueString())
+	assert.Equal(t, "YELLOW", state.CollectionDetails[1].Health.ValueString())
+}
+
+func TestCollectionsDataSourceMissingAlias(t *testing.T) {
+	server := testCollectionsServer(t)
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{Host: server.URL})
+	require.NoError(t, err)
+
+	resp := readDataSource(t, &collectionsDataSource{client: *client}, map[string]tftypes.Value{
+		"alias": tftypes.NewValue(tftypes.String, "music"),
+	})
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Alias not found", resp.Diagnostics.Errors()[0].Summary())
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &healthDataSource{}
+	_ datasource.DataSourceWithConfigure = &healthDataSource{}
+)
+
+func NewHealthDataSource() datasource.DataSource {
+	return &healthDataSource{}
+}
+
+type healthDataSource struct {
+	client Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *healthDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *solrcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = *client
+}
+
+func (d *healthDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_health"
+}
+
+// Schema defines the schema for the data source.
+func (d *healthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Combines the collection and shard health from CLUSTERSTATUS with /admin/info/health of every live node. " +
+			"Intended for check blocks and preconditions.",
+		Attributes: map[string]schema.Attribute{
+			"collection": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only check this collection.",
+			},
+			"check_nodes": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Call /admin/info/health on every live node. Defaults to true.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The overall status: GREEN, YELLOW, ORANGE or RED. A failing node health check makes the status at least YELLOW.",
+			},
+			"healthy": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the status is GREEN and no problems were found.",
+			},
+			"problems": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Human readable descriptions of every unhealthy shard and node.",
+			},
+			"collections": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The health of each collection, keyed by name.",
+			},
+			"nodes": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The health check result (OK or FAILURE) of each live node, keyed by name.",
+			},
+		},
+	}
+}
+
+type healthDataSourceModel struct {
+	Collection  types.String   `tfsdk:"collection"`
+	CheckNodes  types.Bool     `tfsdk:"check_nodes"`
+	Status      types.String   `tfsdk:"status"`
+	Healthy     types.Bool     `tfsdk:"healthy"`
+	Problems    []types.String `tfsdk:"problems"`
+	Collections types.Map      `tfsdk:"collections"`
+	Nodes       types.Map      `tfsdk:"nodes"`
+}
+
+func (d *healthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state healthDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, err := d.client.GetClusterStatus(ctx, ClusterStatusFilter{Collection: state.Collection.ValueString()})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to fetch cluster status", "Unable to fetch cluster status: ", err)
+		return
+	}
+
+	nodeErrors := map[string]error{}
+	if state.CheckNodes.IsNull() || state.CheckNodes.ValueBool() {
+		for _, node := range cluster.LiveNodes {
+			nodeErrors[node] = d.client.GetNodeHealth(ctx, nodeBaseURL(cluster, node, d.client.HostURL))
+		}
+	}
+
+	report := healthReport(cluster, nodeErrors)
+
+	state.Status = types.StringValue(report.Status)
+	state.Healthy = types.BoolValue(report.Status == "GREEN" && len(report.Problems) == 0)
+	state.Problems = []types.String{}
+	for _, problem := range report.Problems {
+		state.Problems = append(state.Problems, types.StringValue(problem))
+	}
+	state.Collections, diags = types.MapValueFrom(ctx, types.StringType, report.Collections)
+	resp.Diagnostics.Append(diags...)
+	state.Nodes, diags = types.MapValueFrom(ctx, types.StringType, report.Nodes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &metricsDataSource{}
+	_ datasource.DataSourceWithConfigure = &metricsDataSource{}
+)
+
+func NewMetricsDataSource() datasource.DataSource {
+	return &metricsDataSource{}
+}
+
+type metricsDataSource struct {
+	client Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *metricsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *solrcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = *client
+}
+
+func (d *metricsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metrics"
+}
+
+// Schema defines the schema for the data source.
+func (d *metricsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads metrics from /admin/metrics of a single node: the first healthy node of `host` and `hosts`, " +
+			"or of the live nodes when only `zk_hosts` is set. Values are keyed by registry:metric, " +
+			"with compound metrics such as caches expanded to registry:metric:property.",
+		Attributes: map[string]schema.Attribute{
+			"group": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Metric groups to return, e.g. core, node or jvm.",
+			},
+			"type": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Metric types to return, e.g. gauge, counter or timer.",
+			},
+			"prefix": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return metrics whose name starts with one of these prefixes, e.g. INDEX.sizeInBytes.",
+			},
+			"key": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Fully qualified metric keys (registry:metric[:property]) to return. Other filters are ignored by Solr when set.",
+			},
+			"node_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The base URL of the node the metrics were read from.",
+			},
+			"values": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.Float64Type,
+				Description: "Numeric metric values.",
+			},
+			"string_values": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Non-numeric metric values.",
+			},
+		},
+	}
+}
+
+type metricsDataSourceModel struct {
+	Group        []types.String `tfsdk:"group"`
+	Type         []types.String `tfsdk:"type"`
+	Prefix       []types.String `tfsdk:"prefix"`
+	Key          []types.String `tfsdk:"key"`
+	NodeURL      types.String   `tfsdk:"node_url"`
+	Values       types.Map      `tfsdk:"values"`
+	StringValues types.Map      `tfsdk:"string_values"`
+}
+
+func (d *metricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state metricsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metrics, err := d.client.GetMetrics(ctx, MetricsFilter{
+		Groups:   stringValues(state.Group),
+		Types:    stringValues(state.Type),
+		Prefixes: stringValues(state.Prefix),
+		Keys:     stringValues(state.Key),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to fetch metrics", "Unable to fetch metrics: ", err)
+		return
+	}
+
+	state.NodeURL = types.StringValue(metrics.Node)
+	state.Values, diags = types.MapValueFrom(ctx, types.Float64Type, metrics.Numbers)
+	resp.Diagnostics.Append(diags...)
+	state.StringValues, diags = types.MapValueFrom(ctx, types.StringType, metrics.Strings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// stringValues converts a list of known Terraform strings to Go strings.
+func stringValues(values []types.String) []string {
+	var result []string
+	for _, value := range values {
+		result = append(result, value.ValueString())
+	}
+	return result
+}
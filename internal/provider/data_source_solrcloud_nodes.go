@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &nodesDataSource{}
+	_ datasource.DataSourceWithConfigure = &nodesDataSource{}
+)
+
+func NewNodesDataSource() datasource.DataSource {
+	return &nodesDataSource{}
+}
+
+type nodesDataSource struct {
+	client Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *nodesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *solrcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = *client
+}
+
+func (d *nodesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nodes"
+}
+
+// Schema defines the schema for the data source.
+func (d *nodesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the live nodes of the cluster with their roles and core counts.",
+		Attributes: map[string]schema.Attribute{
+			"live_nodes": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The names of the live nodes, suitable for create_node_set.",
+			},
+			"nodes": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"base_url": schema.StringAttribute{
+							Computed: true,
+						},
+						"roles": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The mode of each role assigned to the node, e.g. data = on or overseer = preferred.",
+						},
+						"core_count": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of replicas hosted on the node.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type nodesDataSourceModel struct {
+	LiveNodes []types.String `tfsdk:"live_nodes"`
+	Nodes     []nodeModel    `tfsdk:"nodes"`
+}
+
+type nodeModel struct {
+	Name      types.String `tfsdk:"name"`
+	BaseURL   types.String `tfsdk:"base_url"`
+	Roles     types.Map    `tfsdk:"roles"`
+	CoreCount types.Int64  `tfsdk:"core_count"`
+}
+
+func (d *nodesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state nodesDataSourceModel
+
+	cluster, err := d.client.GetClusterStatus(ctx, ClusterStatusFilter{})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to fetch cluster status", "Unable to fetch cluster status: ", err)
+		return
+	}
+
+	roles := d.client.GetNodeRoles(ctx, cluster)
+	counts := nodeCoreCounts(cluster)
+
+	state.LiveNodes = []types.String{}
+	state.Nodes = []nodeModel{}
+	for _, node := range cluster.LiveNodes {
+		nodeRoles := roles[node]
+		if nodeRoles == nil {
+			nodeRoles = map[string]string{}
+		}
+		roleMap, diags := types.MapValueFrom(ctx, types.StringType, nodeRoles)
+		resp.Diagnostics.Append(diags...)
+
+		state.LiveNodes = append(state.LiveNodes, types.StringValue(node))
+		state.Nodes = append(state.Nodes, nodeModel{
+			Name:      types.StringValue(node),
+			BaseURL:   types.StringValue(nodeBaseURL(cluster, node, d.client.HostURL)),
+			Roles:     roleMap,
+			CoreCount: types.Int64Value(int64(counts[node])),
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &systemDataSource{}
+	_ datasource.DataSourceWithConfigure = &systemDataSource{}
+)
+
+func NewSystemDataSource() datasource.DataSource {
+	return &systemDataSource{}
+}
+
+type systemDataSource struct {
+	client Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *systemDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *solrcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = *client
+}
+
+func (d *systemDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system"
+}
+
+// Schema defines the schema for the data source.
+func (d *systemDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads version, JVM and mode information from /admin/info/system of a single node: the first healthy node of `host` and `hosts`, " +
+			"or of the live nodes when only `zk_hosts` is set. The `node` attribute names the node that answered.",
+		Attributes: map[string]schema.Attribute{
+			"solr_spec_version": schema.StringAttribute{
+				Computed: true,
+			},
+			"solr_impl_version": schema.StringAttribute{
+				Computed: true,
+			},
+			"lucene_spec_version": schema.StringAttribute{
+				Computed: true,
+			},
+			"lucene_impl_version": schema.StringAttribute{
+				Computed: true,
+			},
+			"mode": schema.StringAttribute{
+				Computed:    true,
+				Description: "Either solrcloud or std (standalone).",
+			},
+			"zk_host": schema.StringAttribute{
+				Computed: true,
+			},
+			"node": schema.StringAttribute{
+				Computed: true,
+			},
+			"solr_home": schema.StringAttribute{
+				Computed: true,
+			},
+			"jvm_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"jvm_version": schema.StringAttribute{
+				Computed: true,
+			},
+			"jvm_processors": schema.Int64Attribute{
+				Computed: true,
+			},
+			"jvm_memory_max_bytes": schema.Int64Attribute{
+				Computed: true,
+			},
+			"jvm_memory_used_bytes": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+type systemDataSourceModel struct {
+	SolrSpecVersion    types.String `tfsdk:"solr_spec_version"`
+	SolrImplVersion    types.String `tfsdk:"solr_impl_version"`
+	LuceneSpecVersion  types.String `tfsdk:"lucene_spec_version"`
+	LuceneImplVersion  types.String `tfsdk:"lucene_impl_version"`
+	Mode               types.String `tfsdk:"mode"`
+	ZkHost             types.String `tfsdk:"zk_host"`
+	Node               types.String `tfsdk:"node"`
+	SolrHome           types.String `tfsdk:"solr_home"`
+	JVMName            types.String `tfsdk:"jvm_name"`
+	JVMVersion         types.String `tfsdk:"jvm_version"`
+	JVMProcessors      types.Int64  `tfsdk:"jvm_processors"`
+	JVMMemoryMaxBytes  types.Int64  `tfsdk:"jvm_memory_max_bytes"`
+	JVMMemoryUsedBytes types.Int64  `tfsdk:"jvm_memory_used_bytes"`
+}
+
+func (d *systemDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	info, err := d.client.GetSystemInfo(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to fetch system info", "Unable to fetch system info: ", err)
+		return
+	}
+
+	state := systemDataSourceModel{
+		SolrSpecVersion:    types.StringValue(info.Lucene.SolrSpecVersion),
+		SolrImplVersion:    types.StringValue(info.Lucene.SolrImplVersion),
+		LuceneSpecVersion:  types.StringValue(info.Lucene.LuceneSpecVersion),
+		LuceneImplVersion:  types.StringValue(info.Lucene.LuceneImplVersion),
+		Mode:               types.StringValue(info.Mode),
+		ZkHost:             types.StringValue(info.ZkHost),
+		Node:               types.StringValue(info.Node),
+		SolrHome:           types.StringValue(info.SolrHome),
+		JVMName:            types.StringValue(info.JVM.Name),
+		JVMVersion:         types.StringValue(info.JVM.Version),
+		JVMProcessors:      types.Int64Value(int64(info.JVM.Processors)),
+		JVMMemoryMaxBytes:  types.Int64Value(info.JVM.Memory.Raw.Max),
+		JVMMemoryUsedBytes: types.Int64Value(info.JVM.Memory.Raw.Used),
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
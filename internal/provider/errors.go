@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// SolrError is an error response of the Solr API. It is parsed from the
+// error object Solr sends with both v1 and v2 responses:
+//
+//	{"responseHeader": {"status": 400},
+//	 "error": {"metadata": ["error-class", "..."], "msg": "...", "code": 400, "trace": "..."}}
+//
+// and from the exception object the Collections API adds to failed
+// operations.
+type SolrError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Status is responseHeader.status.
+	Status int
+	// Code is error.code, or exception.rspCode.
+	Code     int
+	Msg      string
+	Metadata map[string]string
+	Trace    string
+	Body     []byte
+}
+
+// solrErrorResponse is the part of a Solr response describing an error.
+type solrErrorResponse struct {
+	ResponseHeader ResponseHeader `json:"responseHeader"`
+	Error          *struct {
+		Metadata json.RawMessage `json:"metadata"`
+		Msg      string          `json:"msg"`
+		Code     int             `json:"code"`
+		Trace    string          `json:"trace"`
+	} `json:"error"`
+	Exception *struct {
+		Msg     string `json:"msg"`
+		RspCode int    `json:"rspCode"`
+	} `json:"exception"`
+}
+
+// newSolrError parses the body of an error response. Bodies that are not
+// Solr JSON, such as the HTML error pages of proxies, are kept as they are.
+func newSolrError(statusCode int, body []byte) *SolrError {
+	e := &SolrError{StatusCode: statusCode, Body: body}
+
+	var response solrErrorResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return e
+	}
+
+	e.Status = response.ResponseHeader.Status
+	if response.Exception != nil {
+		e.Msg = response.Exception.Msg
+		e.Code = response.Exception.RspCode
+	}
+	if response.Error != nil {
+		if response.Error.Msg != "" {
+			e.Msg = response.Error.Msg
+		}
+		if response.Error.Code != 0 {
+			e.Code = response.Error.Code
+		}
+		e.Trace = response.Error.Trace
+		e.Metadata = parseErrorMetadata(response.Error.Metadata)
+	}
+
+	// Traces carry the message on their first line when msg is missing.
+	if e.Msg == "" && e.Trace != "" {
+		e.Msg, _, _ = strings.Cut(e.Trace, "\n")
+	}
+
+	return e
+}
+
+// parseErrorMetadata reads error.metadata, which v1 responses write as a
+// flat list of alternating keys and values and v2 responses as an object.
+func parseErrorMetadata(raw json.RawMessage) map[string]string {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	var object map[string]string
+	if err := json.Unmarshal(raw, &object); err == nil {
+		return object
+	}
+
+	var list []string
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return nil
+	}
+
+	metadata := map[string]string{}
+	for i := 0; i+1 < len(list); i += 2 {
+		metadata[list[i]] = list[i+1]
+	}
+	return metadata
+}
+
+func (e *SolrError) Error() string {
+	if e.Msg == "" {
+		return fmt.Sprintf("status: %d, body: %s", e.StatusCode, e.Body)
+	}
+
+	if class := e.Metadata["root-error-class"]; class != "" {
+		return fmt.Sprintf("status: %d, %s (%s)", e.StatusCode, e.Msg, class)
+	}
+	return fmt.Sprintf("status: %d, %s", e.StatusCode, e.Msg)
+}
+
+// remediation returns a diagnostic summary and a hint for errors with a
+// known cause.
+func (e *SolrError) remediation() (summary, hint string, ok bool) {
+	msg := strings.ToLower(e.Msg)
+
+	switch {
+	case e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden:
+		return "SolrCloud API Access Denied",
+			"Check the credentials the provider is configured with and the permissions the Solr authorization plugin grants them.", true
+	case strings.Contains(msg, "collection already exists"):
+		return "Collection Already Exists",
+			"Import the existing collection with `terraform import solrcloud_collection.<name> <collection>`, choose another name, or delete the collection first.", true
+	case strings.Contains(msg, "can not find the specified config set") || strings.Contains(msg, "could not find configname") ||
+		strings.Contains(msg, "specified config does not exist"):
+		return "Configset Not Found",
+			"Upload the configset with `solr zk upconfig` or the Configsets API. Collections created by solrcloud_collection use Solr's _default configset, which must exist in ZooKeeper.", true
+	case strings.Contains(msg, "not enough eligible nodes") || strings.Contains(msg, "nodes currently live") ||
+		strings.Contains(msg, "no live solrservers"):
+		return "Not Enough Live Solr Nodes",
+			"Start more Solr nodes, lower num_shards or replication_factor, or add nodes to create_node_set of solrcloud_shard.", true
+	}
+
+	return "", "", false
+}
+
+// isCollectionNotFound reports whether err is Solr's answer to a request
+// for a collection that does not exist.
+func isCollectionNotFound(err error) bool {
+	var solrErr *SolrError
+	if !errors.As(err, &solrErr) {
+		return false
+	}
+
+	msg := strings.ToLower(solrErr.Msg)
+	return (strings.HasPrefix(msg, "collection: ") && strings.HasSuffix(msg, " not found")) ||
+		strings.Contains(msg, "could not find collection")
+}
+
+// addClientError adds an error diagnostic for a failed client call, using a
+// dedicated summary and a remediation hint when the cluster's Solr version
+// is too old or Solr reported an error with a known cause.
+func addClientError(diags *diag.Diagnostics, summary, detail string, err error) {
+	var versionErr *UnsupportedVersionError
+	if errors.As(err, &versionErr) {
+		diags.AddError(
+			"Unsupported Solr Version",
+			fmt.Sprintf("%s. Upgrade the cluster to Solr %s or newer, or remove the setting that needs it.", versionErr, versionErr.Required),
+		)
+		return
+	}
+
+	var solrErr *SolrError
+	if errors.As(err, &solrErr) {
+		if solrSummary, hint, ok := solrErr.remediation(); ok {
+			diags.AddError(solrSummary, detail+err.Error()+"\n\n"+hint)
+			return
+		}
+	}
+
+	diags.AddError(summary, detail+err.Error())
+}
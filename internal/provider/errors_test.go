@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSolrError(t *testing.T) {
+	v1 := newSolrError(400, []byte(`{
+		"responseHeader": {"status": 400, "QTime": 3},
+		"Operation create caused exception:": "collection already exists: films",
+		"exception": {"msg": "collection already exists: films", "rspCode": 400},
+		"error": {
+			"metadata": ["error-class", "org.apache.solr.common.SolrException", "root-error-class", "org.apache.solr.common.SolrException"],
+			"msg": "collection already exists: films",
+			"code": 400
+		}
+	}`))
+	assert.Equal(t, 400, v1.Status)
+	assert.Equal(t, 400, v1.Code)
+	assert.Equal(t, "collection already exists: films", v1.Msg)
+	assert.Equal(t, "org.apache.solr.common.SolrException", v1.Metadata["root-error-class"])
+
+	v2 := newSolrError(500, []byte(`{
+		"responseHeader": {"status": 500},
+		"error": {
+			"metadata": {"error-class": "org.apache.zookeeper.KeeperException$SessionExpiredException"},
+			"trace": "KeeperErrorCode = Session expired for /live_nodes\n\tat org.apache.zookeeper..."
+		}
+	}`))
+	assert.Equal(t, "KeeperErrorCode = Session expired for /live_nodes", v2.Msg)
+	assert.Equal(t, "org.apache.zookeeper.KeeperException$SessionExpiredException", v2.Metadata["error-class"])
+
+	proxy := newSolrError(502, []byte("<html>Bad Gateway</html>"))
+	assert.Equal(t, "status: 502, body: <html>Bad Gateway</html>", proxy.Error())
+}
+
+func TestSolrErrorRemediation(t *testing.T) {
+	for msg, summary := range map[string]string{
+		"collection already exists: films":                  "Collection Already Exists",
+		"Can not find the specified config set: films_conf": "Configset Not Found",
+		"Could not find configName for collection films":    "Configset Not Found",
+		"Not enough eligible nodes to place 6 replica(s)":   "Not Enough Live Solr Nodes",
+		"Cannot create collection films. Value of maxShardsPerNode is 1, and the number of nodes currently live or live and part of your createNodeSet is 2": "Not Enough Live Solr Nodes",
+		"Specified config does not exist in ZooKeeper: films_conf": "Configset Not Found",
+		"No live SolrServers available to handle this request":     "Not Enough Live Solr Nodes",
+		"Not enough free disk space to perform index split":        "",
+		"undefined field titel":                                    "",
+	} {
+		got, hint, ok := (&SolrError{StatusCode: 400, Msg: msg}).remediation()
+		assert.Equal(t, summary, got, msg)
+		assert.Equal(t, summary != "", ok, msg)
+		assert.Equal(t, summary != "", hint != "", msg)
+	}
+
+	_, _, ok := (&SolrError{StatusCode: 401}).remediation()
+	assert.True(t, ok)
+}
+
+func TestAddClientError(t *testing.T) {
+	var diags diag.Diagnostics
+	err := fmt.Errorf("async request 1 failed: %w", &SolrError{StatusCode: 400, Msg: "collection already exists: films"})
+	addClientError(&diags, "Error creating collection", "Could not create collection: ", err)
+	require.Len(t, diags, 1)
+	assert.Equal(t, "Collection Already Exists", diags[0].Summary())
+
+	diags = nil
+	addClientError(&diags, "Error creating collection", "Could not create collection: ", errors.New("boom"))
+	assert.Equal(t, "Error creating collection", diags[0].Summary())
+}
+
+func TestGetCollectionStatusError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/solr/admin/info/system" {
+			fmt.Fprint(w, `{"lucene":{"solr-spec-version":"9.4.0"}}`)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"responseHeader":{"status":404},"error":{"msg":"Collection: films not found","code":404}}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{Host: server.URL})
+	require.NoError(t, err)
+
+	_, err = client.GetCollectionStatus(context.Background(), "films")
+	var solrErr *SolrError
+	require.ErrorAs(t, err, &solrErr)
+	assert.Equal(t, "Collection: films not found", solrErr.Msg)
+}
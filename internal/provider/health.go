@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Health levels reported by CLUSTERSTATUS, from best to worst.
+var healthLevels = []string{"GREEN", "YELLOW", "ORANGE", "RED"}
+
+// worseHealth returns the worse of two CLUSTERSTATUS health levels. Empty
+// levels, reported by Solr versions without health, are ignored and unknown
+// levels rank as RED.
+func worseHealth(a, b string) string {
+	if b == "" {
+		return a
+	}
+	rank := func(health string) int {
+		for i, level := range healthLevels {
+			if strings.EqualFold(level, health) {
+				return i
+			}
+		}
+		return len(healthLevels) - 1
+	}
+	if rank(b) > rank(a) {
+		return healthLevels[rank(b)]
+	}
+	return healthLevels[rank(a)]
+}
+
+// GetNodeHealth calls /admin/info/health on the node at baseURL and returns an
+// error describing why the node is unhealthy.
+func (c *Client) GetNodeHealth(ctx context.Context, baseURL string) error {
+	return c.getJSON(withPinnedNode(ctx), strings.TrimSuffix(baseURL, "/")+"/admin/info/health?wt=json", nil)
+}
+
+// HealthReport is the combined health of collections and nodes.
+type HealthReport struct {
+	Status      string
+	Collections map[string]string
+	Nodes       map[string]string
+	Problems    []string
+}
+
+// healthReport derives a report from CLUSTERSTATUS and the per-node health
+// check results, keyed by node name with nil for healthy nodes.
+func healthReport(cluster ClusterInfo, nodeErrors map[string]error) HealthReport {
+	report := HealthReport{
+		Status:      "GREEN",
+		Collections: map[string]string{},
+		Nodes:       map[string]string{},
+	}
+
+	for _, name := range sortedKeys(cluster.Collections) {
+		collection := cluster.Collections[name]
+		report.Collections[name] = collection.Health
+		report.Status = worseHealth(report.Status, collection.Health)
+
+		for _, shardName := range sortedKeys(collection.Shards) {
+			shard := collection.Shards[shardName]
+			if shard.State != "active" || shard.Health == "" || strings.EqualFold(shard.Health, "GREEN") {
+				continue
+			}
+
+			var unhealthy []string
+			for _, replicaName := range sortedKeys(shard.Replicas) {
+				replica := shard.Replicas[replicaName]
+				if replica.State != "active" {
+					unhealthy = append(unhealthy, fmt.Sprintf("%s is %s on %s", replicaName, replica.State, replica.NodeName))
+				}
+			}
+			problem := fmt.Sprintf("shard %s/%s is %s", name, shardName, shard.Health)
+			if len(unhealthy) > 0 {
+				problem += ": " + strings.Join(unhealthy, ", ")
+			}
+			report.Problems = append(report.Problems, problem)
+		}
+	}
+
+	for _, node := range sortedKeys(nodeErrors) {
+		if err := nodeErrors[node]; err != nil {
+			report.Nodes[node] = "FAILURE"
+			report.Problems = append(report.Problems, fmt.Sprintf("node %s failed its health check: %s", node, err))
+			report.Status = worseHealth(report.Status, "YELLOW")
+			continue
+		}
+		report.Nodes[node] = "OK"
+	}
+
+	return report
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthReport(t *testing.T) {
+	cluster := testCluster()
+	products := cluster.Collections["products"]
+	products.Health = "YELLOW"
+	products.Shards["shard1"] = ShardInfo{State: "active", Health: "GREEN", Replicas: products.Shards["shard1"].Replicas}
+	products.Shards["shard2"] = ShardInfo{State: "active", Health: "YELLOW", Replicas: map[string]ReplicaInfo{
+		"core_node3": {NodeName: "a:8983_solr", State: "active"},
+		"core_node4": {NodeName: "c:8983_solr", State: "down"},
+	}}
+	cluster.Collections["products"] = products
+
+	report := healthReport(cluster, map[string]error{"a:8983_solr": nil})
+	assert.Equal(t, "YELLOW", report.Status)
+	assert.Equal(t, []string{"shard products/shard2 is YELLOW: core_node4 is down on c:8983_solr"}, report.Problems)
+	assert.Equal(t, map[string]string{"a:8983_solr": "OK"}, report.Nodes)
+
+	products.Health = "GREEN"
+	products.Shards["shard2"] = ShardInfo{State: "active", Health: "GREEN"}
+	cluster.Collections["products"] = products
+	report = healthReport(cluster, map[string]error{"c:8983_solr": errors.New("status: 503")})
+	assert.Equal(t, "YELLOW", report.Status)
+	assert.Equal(t, map[string]string{"c:8983_solr": "FAILURE"}, report.Nodes)
+	assert.Len(t, report.Problems, 1)
+}
+
+func TestWorseHealth(t *testing.T) {
+	assert.Equal(t, "ORANGE", worseHealth("YELLOW", "ORANGE"))
+	assert.Equal(t, "ORANGE", worseHealth("ORANGE", "GREEN"))
+	assert.Equal(t, "GREEN", worseHealth("GREEN", ""))
+	assert.Equal(t, "RED", worseHealth("GREEN", "PURPLE"))
+}
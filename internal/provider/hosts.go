@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// hostDownPeriod is how long a node that failed a request is tried only
+// after all other nodes.
+var hostDownPeriod = 30 * time.Second
+
+// liveNodesRefreshInterval is how often the live nodes are read again from a
+// liveNodesSource.
+var liveNodesRefreshInterval = time.Minute
+
+type pinnedNodeKey struct{}
+
+// withPinnedNode marks requests made with ctx as meant for the node in their
+// URL, such as health checks and metrics of a specific node. They are never
+// sent to, or failed over to, another node.
+func withPinnedNode(ctx context.Context) context.Context {
+	return context.WithValue(ctx, pinnedNodeKey{}, true)
+}
+
+// isPinnedNode reports whether req was made with withPinnedNode.
+func isPinnedNode(req *http.Request) bool {
+	pinned, _ := req.Context().Value(pinnedNodeKey{}).(bool)
+	return pinned
+}
+
+// hostPool spreads requests over several Solr nodes round-robin and fails
+// over to another node when one is unreachable. Nodes reported as live by
+// the cluster are preferred once they are known.
+type hostPool struct {
+	// source, if set, is asked for the live nodes instead of the cluster.
+	source      liveNodesSource
+	refreshedAt time.Time
+
+	mu sync.Mutex
+
+	// origins lists every known node as scheme://host:port, configured
+	// nodes first.
+	origins   []string
+	downUntil map[string]time.Time
+	live      map[string]bool
+	next      int
+
+	discovered bool
+}
+
+// newHostPool returns a pool of the given host URLs, or nil if there are
+// fewer than two of them, no source of live nodes, and failover is not
+// possible.
+func newHostPool(hosts []string, source liveNodesSource) (*hostPool, error) {
+	p := &hostPool{
+		source:    source,
+		downUntil: map[string]time.Time{},
+		live:      map[string]bool{},
+	}
+
+	for _, host := range hosts {
+		origin, err := hostOrigin(host)
+		if err != nil {
+			return nil, err
+		}
+		p.add(origin)
+	}
+
+	if len(p.origins) < 2 && source == nil {
+		return nil, nil
+	}
+	return p, nil
+}
+
+// hostOrigin returns the scheme://host:port part of a host URL.
+func hostOrigin(host string) (string, error) {
+	u, err := url.Parse(host)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return "", fmt.Errorf("invalid SolrCloud host %q, expected a URL such as http://localhost:8983", host)
+	}
+	return u.Scheme + "://" + u.Host, nil
+}
+
+func (p *hostPool) add(origin string) {
+	for _, known := range p.origins {
+		if known == origin {
+			return
+		}
+	}
+	p.origins = append(p.origins, origin)
+}
+
+// candidates returns every known node in the order they should be tried:
+// live and healthy nodes first, starting at the next node in round-robin
+// order, then other healthy nodes, then nodes that recently failed.
+func (p *hostPool) candidates() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.origins) == 0 {
+		return nil
+	}
+
+	start := p.next % len(p.origins)
+	p.next++
+	return p.ordered(start)
+}
+
+// preferred returns the node that requests meant for a single node go to:
+// the first live and healthy node in configured order. Unlike candidates it
+// does not advance the round-robin order, so it stays the same node for as
+// long as that node is healthy.
+func (p *hostPool) preferred() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.origins) == 0 {
+		return ""
+	}
+	return p.ordered(0)[0]
+}
+
+// ordered returns every known node starting at origins[start], grouped as
+// described for candidates. p.mu must be held.
+func (p *hostPool) ordered(start int) []string {
+	now := time.Now()
+	var live, healthy, down []string
+	for i := range p.origins {
+		origin := p.origins[(start+i)%len(p.origins)]
+		switch {
+		case p.downUntil[origin].After(now):
+			down = append(down, origin)
+		case len(p.live) == 0 || p.live[origin]:
+			live = append(live, origin)
+		default:
+			healthy = append(healthy, origin)
+		}
+	}
+
+	return append(append(live, healthy...), down...)
+}
+
+// markDown records that a request to origin failed.
+func (p *hostPool) markDown(origin string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.downUntil[origin] = time.Now().Add(hostDownPeriod)
+}
+
+// markUp records that origin answered a request.
+func (p *hostPool) markUp(origin string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.downUntil, origin)
+}
+
+// needsDiscovery reports whether the live nodes should be looked up in the
+// cluster status because they have not been yet, and marks them as looked up.
+func (p *hostPool) needsDiscovery() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	discovered := p.discovered || p.source != nil
+	p.discovered = true
+	return !discovered
+}
+
+// refresh reads the live nodes from the source of the pool, if it has one
+// and they have not been read recently.
+func (p *hostPool) refresh(ctx context.Context) error {
+	p.mu.Lock()
+	stale := p.source != nil && time.Since(p.refreshedAt) >= liveNodesRefreshInterval
+	p.mu.Unlock()
+	if !stale {
+		return nil
+	}
+
+	urls, err := p.source.LiveNodeURLs(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.setLiveURLs(urls)
+
+	p.mu.Lock()
+	p.refreshedAt = time.Now()
+	p.mu.Unlock()
+	return nil
+}
+
+// setLiveNodes adds the live nodes of the cluster, given as Solr node names
+// such as 10.0.0.1:8983_solr, to the pool and prefers them from now on.
+func (p *hostPool) setLiveNodes(nodes []string) {
+	p.mu.Lock()
+	scheme := "http"
+	if len(p.origins) > 0 {
+		scheme, _, _ = strings.Cut(p.origins[0], "://")
+	}
+	p.mu.Unlock()
+
+	urls := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		hostPort, _, _ := strings.Cut(node, "_")
+		urls = append(urls, scheme+"://"+hostPort)
+	}
+
+	p.setLiveURLs(urls)
+}
+
+// setLiveURLs adds the given live node base URLs to the pool and prefers
+// them from now on.
+func (p *hostPool) setLiveURLs(urls []string) {
+	if len(urls) == 0 {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.live = map[string]bool{}
+	for _, origin := range urls {
+		p.live[origin] = true
+		p.add(origin)
+	}
+	p.discovered = true
+}
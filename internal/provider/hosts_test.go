@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostPoolCandidates(t *testing.T) {
+	pool, err := newHostPool([]string{"http://a:8983", "http://b:8983", "http://c:8983/solr"}, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"http://a:8983", "http://b:8983", "http://c:8983"}, pool.candidates())
+	assert.Equal(t, []string{"http://b:8983", "http://c:8983", "http://a:8983"}, pool.candidates())
+
+	pool.markDown("http://c:8983")
+	assert.Equal(t, []string{"http://a:8983", "http://b:8983", "http://c:8983"}, pool.candidates())
+
+	pool.setLiveNodes([]string{"b:8983_solr", "d:8983_solr"})
+	assert.Equal(t, []string{"http://d:8983", "http://b:8983", "http://a:8983", "http://c:8983"}, pool.candidates())
+
+	single, err := newHostPool([]string{"http://a:8983"}, nil)
+	require.NoError(t, err)
+	assert.Nil(t, single)
+
+	_, err = newHostPool([]string{"localhost:8983", "http://b:8983"}, nil)
+	assert.Error(t, err)
+}
+
+func TestDoRequestFailover(t *testing.T) {
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unavailable.Close()
+
+	var healthy *httptest.Server
+	healthy = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		node := strings.TrimPrefix(healthy.URL, "http://") + "_solr"
+		fmt.Fprintf(w, `{"cluster":{"collections":{},"live_nodes":[%q]}}`, node)
+	}))
+	defer healthy.Close()
+
+	stopped := httptest.NewServer(http.NotFoundHandler())
+	stopped.Close()
+
+	client, err := NewClient(ClientConfig{
+		Hosts: []string{stopped.URL, unavailable.URL, healthy.URL},
+	})
+	require.NoError(t, err)
+
+	_, err = client.GetClusterStatus(context.Background(), ClusterStatusFilter{})
+	require.NoError(t, err)
+
+	// The healthy node is the only live node, so it is tried first.
+	assert.Equal(t, healthy.URL, client.hosts.candidates()[0])
+}
+
+func TestPinnedNodeSkipsFailover(t *testing.T) {
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unavailable.Close()
+
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status":"OK"}`)
+	}))
+	defer healthy.Close()
+
+	client, err := NewClient(ClientConfig{
+		Hosts: []string{unavailable.URL, healthy.URL},
+		Retry: RetryConfig{MaxRetries: -1},
+	})
+	require.NoError(t, err)
+
+	// The unhealthy node must report its own 503 instead of the healthy
+	// node answering for it.
+	err = client.GetNodeHealth(context.Background(), unavailable.URL+"/solr")
+	var solrErr *SolrError
+	require.ErrorAs(t, err, &solrErr)
+	assert.Equal(t, http.StatusServiceUnavailable, solrErr.StatusCode)
+}
+
+func TestNodeRequestsStayOnOneNode(t *testing.T) {
+	hits := map[string]int{}
+	handler := func(name string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			hits[name]++
+			switch r.URL.Path {
+			case "/solr/admin/info/system":
+				fmt.Fprint(w, `{"lucene":{"solr-spec-version":"9.4.0"}}`)
+			default:
+				fmt.Fprint(w, `{"metrics":{}}`)
+			}
+		}
+	}
+	first := httptest.NewServer(handler("first"))
+	defer first.Close()
+	second := httptest.NewServer(handler("second"))
+	defer second.Close()
+
+	client, err := NewClient(ClientConfig{Hosts: []string{first.URL, second.URL}})
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, err = client.GetSystemInfo(context.Background())
+		require.NoError(t, err)
+
+		metrics, err := client.GetMetrics(context.Background(), MetricsFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, first.URL, metrics.Node)
+	}
+	_, err = client.SolrVersion(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]int{"first": 7}, hits)
+
+	// Once the preferred node fails, the next healthy node takes over.
+	client.hosts.markDown(first.URL)
+	metrics, err := client.GetMetrics(context.Background(), MetricsFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, second.URL, metrics.Node)
+}
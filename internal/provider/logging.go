@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// redacted replaces sensitive values in logged requests.
+const redacted = "REDACTED"
+
+// sensitiveHeaders are logged as redacted.
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "X-Api-Key"}
+
+// sensitiveKeys are substrings of JSON field and query parameter names whose
+// values are logged as redacted.
+var sensitiveKeys = []string{"password", "passwd", "secret", "token", "credential", "authorization"}
+
+// sensitiveObjects are JSON fields whose nested values are all logged as
+// redacted, such as the user name to password map of the set-user command
+// of Solr's Basic Authentication API.
+var sensitiveObjects = []string{"set-user"}
+
+// logRequests logs each attempt at a request at DEBUG, and its body at
+// TRACE unless body logging is disabled. Credentials are redacted.
+func (c *Client) logRequests(next requestFunc) requestFunc {
+	return func(req *http.Request) ([]byte, error) {
+		ctx := req.Context()
+
+		headers := map[string]string{}
+		for name := range req.Header {
+			headers[name] = redactHeader(name, req.Header.Get(name))
+		}
+
+		tflog.Debug(ctx, "SolrCloud request", map[string]interface{}{
+			"method":  req.Method,
+			"url":     redactURL(req.URL),
+			"headers": headers,
+		})
+
+		if !c.disableBodyLogging && req.GetBody != nil {
+			if body, err := req.GetBody(); err == nil {
+				data, err := io.ReadAll(body)
+				body.Close()
+				if err == nil && len(data) > 0 {
+					tflog.Trace(ctx, "SolrCloud request body", map[string]interface{}{
+						"body": redactBody(req.Header.Get("Content-Type"), data),
+					})
+				}
+			}
+		}
+
+		return next(req)
+	}
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+func redactHeader(name, value string) string {
+	for _, sensitive := range sensitiveHeaders {
+		if strings.EqualFold(name, sensitive) {
+			return redacted
+		}
+	}
+	return value
+}
+
+// redactURL returns u with its user info and sensitive query parameters
+// redacted.
+func redactURL(u *url.URL) string {
+	redactedURL := *u
+	if u.User != nil {
+		redactedURL.User = url.User(redacted)
+	}
+	redactedURL.RawQuery = redactValues(u.Query()).Encode()
+	return redactedURL.String()
+}
+
+func redactValues(values url.Values) url.Values {
+	for key := range values {
+		if isSensitiveKey(key) {
+			values[key] = []string{redacted}
+		}
+	}
+	return values
+}
+
+// redactBody returns a request body for logging with sensitive JSON fields
+// or form values redacted. Other bodies are only logged by size.
+func redactBody(contentType string, body []byte) string {
+	switch {
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			break
+		}
+		return redactValues(values).Encode()
+	case json.Valid(body):
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.UseNumber()
+
+		var value interface{}
+		if err := decoder.Decode(&value); err != nil {
+			break
+		}
+		data, err := json.Marshal(redactJSON(value))
+		if err != nil {
+			break
+		}
+		return string(data)
+	}
+
+	return "<" + strings.TrimSpace(http.DetectContentType(body)) + " body not logged>"
+}
+
+func redactJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			switch {
+			case isSensitiveKey(key):
+				v[key] = redacted
+			case containsFold(sensitiveObjects, key):
+				v[key] = redactAll(field)
+			default:
+				v[key] = redactJSON(field)
+			}
+		}
+	case []interface{}:
+		for i, element := range v {
+			v[i] = redactJSON(element)
+		}
+	}
+	return value
+}
+
+// redactAll replaces every value in a JSON value, keeping object keys.
+func redactAll(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			v[key] = redactAll(field)
+		}
+		return v
+	case []interface{}:
+		for i, element := range v {
+			v[i] = redactAll(element)
+		}
+		return v
+	}
+	return redacted
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// MetricsFilter selects metrics from /admin/metrics. Every field maps to the
+// query parameter of the same name; empty fields are not sent.
+type MetricsFilter struct {
+	Groups   []string
+	Types    []string
+	Prefixes []string
+	Keys     []string
+}
+
+// MetricValues holds flattened metrics keyed by registry:metric, with
+// compound metrics expanded to registry:metric:property.
+type MetricValues struct {
+	// Node is the base URL of the node the metrics were read from.
+	Node    string
+	Numbers map[string]float64
+	Strings map[string]string
+}
+
+// GetMetrics returns the metrics of a single node, the one nodeURL picks.
+func (c *Client) GetMetrics(ctx context.Context, filter MetricsFilter) (MetricValues, error) {
+	params := url.Values{}
+	params.Set("wt", "json")
+	if len(filter.Groups) > 0 {
+		params.Set("group", strings.Join(filter.Groups, ","))
+	}
+	if len(filter.Types) > 0 {
+		params.Set("type", strings.Join(filter.Types, ","))
+	}
+	if len(filter.Prefixes) > 0 {
+		params.Set("prefix", strings.Join(filter.Prefixes, ","))
+	}
+	for _, key := range filter.Keys {
+		params.Add("key", key)
+	}
+
+	// The response is decoded by parseMetrics. Numbers become float64, so
+	// counters above 2^53 lose precision.
+	node := c.nodeURL(ctx)
+	var body json.RawMessage
+	err := c.getJSON(withPinnedNode(ctx), fmt.Sprintf("%s/solr/admin/metrics?%s", node, params.Encode()), &body)
+	if err != nil {
+		return MetricValues{}, err
+	}
+
+	values, err := parseMetrics(body)
+	values.Node = node
+	return values, err
+}
+
+// parseMetrics flattens a /admin/metrics response. Responses to key queries
+// are already keyed by registry:metric[:property].
+func parseMetrics(body []byte) (MetricValues, error) {
+	var response struct {
+		Metrics map[string]interface{} `json:"metrics"`
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&response); err != nil {
+		return MetricValues{}, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	values := MetricValues{
+		Numbers: map[string]float64{},
+		Strings: map[string]string{},
+	}
+	for registry, metrics := range response.Metrics {
+		if byName, ok := metrics.(map[string]interface{}); ok && !strings.Contains(registry, ":") {
+			for name, value := range byName {
+				values.add(registry+":"+name, value)
+			}
+			continue
+		}
+		values.add(registry, metrics)
+	}
+
+	return values, nil
+}
+
+func (v MetricValues) add(key string, value interface{}) {
+	switch value := value.(type) {
+	case json.Number:
+		if f, err := value.Float64(); err == nil {
+			v.Numbers[key] = f
+		}
+	case string:
+		v.Strings[key] = value
+	case bool:
+		v.Strings[key] = fmt.Sprint(value)
+	case map[string]interface{}:
+		for property, nested := range value {
+			v.add(key+":"+property, nested)
+		}
+	case []interface{}:
+		if encoded, err := json.Marshal(value); err == nil {
+			v.Strings[key] = string(encoded)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMetrics(t *testing.T) {
+	values, err := parseMetrics([]byte(`{
+		"responseHeader": {"status": 0, "QTime": 1},
+		"metrics": {
+			"solr.core.products.shard1.replica_n1": {
+				"INDEX.sizeInBytes": 52428800,
+				"CORE.indexDir": "/var/solr/data/products/data/index",
+				"CACHE.searcher.filterCache": {"hitratio": 0.75, "size": 12}
+			},
+			"solr.jvm:os.processCpuLoad": 0.25
+		}
+	}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]float64{
+		"solr.core.products.shard1.replica_n1:INDEX.sizeInBytes":                   52428800,
+		"solr.core.products.shard1.replica_n1:CACHE.searcher.filterCache:hitratio": 0.75,
+		"solr.core.products.shard1.replica_n1:CACHE.searcher.filterCache:size":     12,
+		"solr.jvm:os.processCpuLoad":                                               0.25,
+	}, values.Numbers)
+	assert.Equal(t, map[string]string{
+		"solr.core.products.shard1.replica_n1:CORE.indexDir": "/var/solr/data/products/data/index",
+	}, values.Strings)
+}
+
+func TestGetMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/solr/admin/metrics", r.URL.Path)
+		assert.Equal(t, "jvm,node", r.URL.Query().Get("group"))
+		assert.Equal(t, []string{"solr.jvm:os.processCpuLoad"}, r.URL.Query()["key"])
+		fmt.Fprint(w, `{"metrics":{"solr.jvm:os.processCpuLoad":0.25,"solr.jvm:os.name":"Linux"}}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{Host: server.URL})
+	require.NoError(t, err)
+
+	values, err := client.GetMetrics(context.Background(), MetricsFilter{
+		Groups: []string{"jvm", "node"},
+		Keys:   []string{"solr.jvm:os.processCpuLoad"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"solr.jvm:os.processCpuLoad": 0.25}, values.Numbers)
+	assert.Equal(t, map[string]string{"solr.jvm:os.name": "Linux"}, values.Strings)
+}
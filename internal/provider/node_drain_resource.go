@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &nodeDrainResource{}
+	_ resource.ResourceWithConfigure      = &nodeDrainResource{}
+	_ resource.ResourceWithValidateConfig = &nodeDrainResource{}
+	_ resource.ResourceWithModifyPlan     = &nodeDrainResource{}
+)
+
+// NewNodeDrainResource is a helper function to simplify the provider implementation.
+func NewNodeDrainResource() resource.Resource {
+	return &nodeDrainResource{}
+}
+
+// nodeDrainResource moves every replica off a node that is being retired.
+type nodeDrainResource struct {
+	client Client
+}
+
+// NodeDrainResourceModel is the model for the solrcloud_node_drain resource.
+type NodeDrainResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	Node              types.String   `tfsdk:"node"`
+	TargetNode        types.String   `tfsdk:"target_node"`
+	Method            types.String   `tfsdk:"method"`
+	RemainingReplicas types.List     `tfsdk:"remaining_replicas"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *nodeDrainResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = *client
+}
+
+// Schema defines the schema for the resource.
+func (r *nodeDrainResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Moves every replica off a Solr node so it can be decommissioned. " +
+			"The node is drained again on the next apply if replicas are found on it. Destroying the resource does not move replicas back.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the drained node.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"node": schema.StringAttribute{
+				Required:    true,
+				Description: "The node to drain, e.g. 10.0.0.1:8983_solr.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_node": schema.StringAttribute{
+				Optional:    true,
+				Description: "The node to move replicas to. When unset, REPLACENODE lets Solr choose and MOVEREPLICA uses the least loaded live node.",
+			},
+			"method": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("replacenode"),
+				Description: "How replicas are moved: replacenode (a single REPLACENODE call) or movereplica (one MOVEREPLICA call per replica).",
+			},
+			"remaining_replicas": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Replicas still hosted on the node, in the form collection/shard/replica.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true}),
+		},
+	}
+}
+
+func (r *nodeDrainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_drain"
+}
+
+// ValidateConfig checks the drain method.
+func (r *nodeDrainResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var method types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("method"), &method)...)
+	if method.IsNull() || method.IsUnknown() {
+		return
+	}
+
+	switch method.ValueString() {
+	case "replacenode", "movereplica":
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("method"),
+			"Invalid Drain Method",
+			fmt.Sprintf("The drain method must be replacenode or movereplica, got %q.", method.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan plans another drain when replicas have landed on the node again.
+func (r *nodeDrainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state NodeDrainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(state.RemainingReplicas.Elements()) > 0 {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("remaining_replicas"), types.ListUnknown(types.StringType))...)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *nodeDrainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan NodeDrainResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts, "create", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.drain(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(plan.Node.ValueString())
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *nodeDrainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state NodeDrainResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, state.Timeouts, "read", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, err := r.client.GetClusterStatus(ctx, ClusterStatusFilter{})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading cluster status", "Could not read cluster status, unexpected error: ", err)
+		return
+	}
+
+	resp.Diagnostics.Append(setRemainingReplicas(ctx, &state, cluster)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update drains the node again with the planned settings.
+func (r *nodeDrainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan NodeDrainResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts, "update", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.drain(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the resource from state; replicas are not moved back.
+func (r *nodeDrainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// drain moves the replicas off the node and records what is left on it.
+func (r *nodeDrainResource) drain(ctx context.Context, plan *NodeDrainResourceModel) (diags diag.Diagnostics) {
+	node := plan.Node.ValueString()
+
+	cluster, err := r.client.GetClusterStatus(ctx, ClusterStatusFilter{})
+	if err != nil {
+		addClientError(&diags, "Error reading cluster status", "Could not read cluster status, unexpected error: ", err)
+		return diags
+	}
+
+	replicas := nodeReplicas(cluster, node)
+	if len(replicas) > 0 {
+		if plan.Method.ValueString() == "movereplica" {
+			err = r.moveReplicas(ctx, cluster, node, replicas, plan.TargetNode.ValueString())
+		} else {
+			err = r.client.ReplaceNode(ctx, node, plan.TargetNode.ValueString())
+		}
+		if err != nil {
+			addClientError(&diags, "Error draining node", "Could not move replicas off node "+node+": ", err)
+			return diags
+		}
+
+		cluster, err = r.client.GetClusterStatus(ctx, ClusterStatusFilter{})
+		if err != nil {
+			addClientError(&diags, "Error reading cluster status", "Could not read cluster status, unexpected error: ", err)
+			return diags
+		}
+	}
+
+	diags.Append(setRemainingReplicas(ctx, plan, cluster)...)
+	if remaining := len(plan.RemainingReplicas.Elements()); remaining > 0 {
+		diags.AddWarning(
+			"Node not fully drained",
+			fmt.Sprintf("%d replicas are still hosted on node %s.", remaining, node),
+		)
+	}
+
+	return diags
+}
+
+// moveReplicas issues one MOVEREPLICA per replica, picking the least loaded
+// node when no target node is configured.
+func (r *nodeDrainResource) moveReplicas(ctx context.Context, cluster ClusterInfo, node string, replicas []NodeReplica, targetNode string) error {
+	for _, replica := range replicas {
+		target := targetNode
+		if target == "" {
+			var err error
+			target, err = leastLoadedNode(cluster, replica, node)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := r.client.MoveReplica(ctx, replica, target); err != nil {
+			return err
+		}
+
+		var err error
+		cluster, err = r.client.GetClusterStatus(ctx, ClusterStatusFilter{})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setRemainingReplicas records the replicas still hosted on the drained node.
+func setRemainingReplicas(ctx context.Context, model *NodeDrainResourceModel, cluster ClusterInfo) diag.Diagnostics {
+	remaining := []string{}
+	for _, replica := range nodeReplicas(cluster, model.Node.ValueString()) {
+		remaining = append(remaining, replica.String())
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.StringType, remaining)
+	model.RemainingReplicas = list
+	return diags
+}
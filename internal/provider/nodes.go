@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// NodeReplica identifies a replica hosted on a node.
+type NodeReplica struct {
+	Collection string
+	Shard      string
+	Replica    string
+	Core       string
+}
+
+// String returns the replica in the form collection/shard/replica.
+func (r NodeReplica) String() string {
+	return r.Collection + "/" + r.Shard + "/" + r.Replica
+}
+
+// ReplaceNode moves every replica off sourceNode and waits for the move to
+// finish. Solr chooses the target nodes when targetNode is empty.
+func (c *Client) ReplaceNode(ctx context.Context, sourceNode, targetNode string) error {
+	params := url.Values{}
+	params.Set("sourceNode", sourceNode)
+	if targetNode != "" {
+		params.Set("targetNode", targetNode)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Replacing node %s", sourceNode))
+
+	return c.collectionsAPIAsync(ctx, "REPLACENODE", params)
+}
+
+// MoveReplica moves a single replica to targetNode and waits for the move to finish.
+func (c *Client) MoveReplica(ctx context.Context, replica NodeReplica, targetNode string) error {
+	params := url.Values{}
+	params.Set("collection", replica.Collection)
+	params.Set("shard", replica.Shard)
+	params.Set("replica", replica.Replica)
+	params.Set("targetNode", targetNode)
+
+	tflog.Info(ctx, fmt.Sprintf("Moving replica %s to %s", replica, targetNode))
+
+	return c.collectionsAPIAsync(ctx, "MOVEREPLICA", params)
+}
+
+// nodeReplicas returns the replicas hosted on node, ordered by collection,
+// shard and replica name.
+func nodeReplicas(cluster ClusterInfo, node string) []NodeReplica {
+	var replicas []NodeReplica
+	for collectionName, collection := range cluster.Collections {
+		for shardName, shard := range collection.Shards {
+			for replicaName, replica := range shard.Replicas {
+				if replica.NodeName == node {
+					replicas = append(replicas, NodeReplica{
+						Collection: collectionName,
+						Shard:      shardName,
+						Replica:    replicaName,
+						Core:       replica.Core,
+					})
+				}
+			}
+		}
+	}
+	sort.Slice(replicas, func(i, j int) bool {
+		return replicas[i].String() < replicas[j].String()
+	})
+	return replicas
+}
+
+// nodeCoreCounts returns the number of replicas hosted on every live node.
+func nodeCoreCounts(cluster ClusterInfo) map[string]int {
+	counts := map[string]int{}
+	for _, node := range cluster.LiveNodes {
+		counts[node] = 0
+	}
+	for _, collection := range cluster.Collections {
+		for _, shard := range collection.Shards {
+			for _, replica := range shard.Replicas {
+				counts[replica.NodeName]++
+			}
+		}
+	}
+	return counts
+}
+
+// leastLoadedNode picks the live node with the fewest replicas that does not
+// already host a replica of the given shard and is not in exclude.
+func leastLoadedNode(cluster ClusterInfo, replica NodeReplica, exclude string) (string, error) {
+	hosting := map[string]bool{}
+	for _, r := range cluster.Collections[replica.Collection].Shards[replica.Shard].Replicas {
+		hosting[r.NodeName] = true
+	}
+
+	counts := nodeCoreCounts(cluster)
+	var best string
+	for _, node := range cluster.LiveNodes {
+		if node == exclude || hosting[node] {
+			continue
+		}
+		if best == "" || counts[node] < counts[best] || (counts[node] == counts[best] && node < best) {
+			best = node
+		}
+	}
+
+	if best == "" {
+		return "", fmt.Errorf("no live node is available to take replica %s", replica)
+	}
+	return best, nil
+}
+
+// NodeRolesResponse is the response of the node roles API available since
+// Solr 9.1. NodeRoles maps a role to its modes (e.g. on, off, preferred) and
+// the nodes in each mode.
+type NodeRolesResponse struct {
+	ResponseHeader ResponseHeader                 `json:"responseHeader"`
+	NodeRoles      map[string]map[string][]string `json:"node-roles"`
+}
+
+// GetNodeRoles returns the mode of every role assigned to each node, keyed by
+// node name and role. Clusters without the node roles API fall back to the
+// roles reported by CLUSTERSTATUS.
+func (c *Client) GetNodeRoles(ctx context.Context, cluster ClusterInfo) map[string]map[string]string {
+	roles := map[string]map[string]string{}
+	assign := func(node, role, mode string) {
+		if roles[node] == nil {
+			roles[node] = map[string]string{}
+		}
+		roles[node][role] = mode
+	}
+
+	err := c.requireSolrVersion(ctx, solrVersionNodeRoles, "the node roles API")
+	var response NodeRolesResponse
+	if err == nil {
+		response, err = c.getNodeRoles(ctx)
+	}
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Node roles API unavailable, using CLUSTERSTATUS roles: %s", err))
+		for role, nodes := range cluster.Roles {
+			for _, node := range nodes {
+				assign(node, role, "on")
+			}
+		}
+		return roles
+	}
+
+	for role, modes := range response.NodeRoles {
+		for mode, nodes := range modes {
+			for _, node := range nodes {
+				assign(node, role, mode)
+			}
+		}
+	}
+	return roles
+}
+
+func (c *Client) getNodeRoles(ctx context.Context) (NodeRolesResponse, error) {
+	var response NodeRolesResponse
+
+	err := c.getJSON(ctx, fmt.Sprintf("%s/api/cluster/node-roles", c.HostURL), &response)
+	return response, err
+}
+
+// nodeBaseURL returns the base URL of a node, preferring the base_url Solr
+// reports for its replicas and otherwise deriving it from a node name such
+// as 10.0.0.1:8983_solr using the scheme of the provider host.
+func nodeBaseURL(cluster ClusterInfo, node, hostURL string) string {
+	for _, collection := range cluster.Collections {
+		for _, shard := range collection.Shards {
+			for _, replica := range shard.Replicas {
+				if replica.NodeName == node && replica.BaseURL != "" {
+					return replica.BaseURL
+				}
+			}
+		}
+	}
+
+	scheme := "http"
+	if u, err := url.Parse(hostURL); err == nil && u.Scheme != "" {
+		scheme = u.Scheme
+	}
+
+	hostPort, contextPath, found := strings.Cut(node, "_")
+	if !found {
+		return scheme + "://" + hostPort
+	}
+	return scheme + "://" + hostPort + "/" + strings.ReplaceAll(contextPath, "%2F", "/")
+}
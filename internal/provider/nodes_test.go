@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testCluster() ClusterInfo {
+	return ClusterInfo{
+		LiveNodes: []string{"a:8983_solr", "b:8983_solr", "c:8983_solr"},
+		Collections: map[string]CollectionInfo{
+			"products": {
+				Shards: map[string]ShardInfo{
+					"shard1": {Replicas: map[string]ReplicaInfo{
+						"core_node1": {Core: "products_shard1_replica_n1", NodeName: "a:8983_solr"},
+						"core_node2": {Core: "products_shard1_replica_n2", NodeName: "b:8983_solr"},
+					}},
+					"shard2": {Replicas: map[string]ReplicaInfo{
+						"core_node3": {Core: "products_shard2_replica_n3", NodeName: "a:8983_solr"},
+						"core_node4": {Core: "products_shard2_replica_n4", NodeName: "c:8983_solr"},
+					}},
+				},
+			},
+		},
+	}
+}
+
+func TestNodeReplicas(t *testing.T) {
+	replicas := nodeReplicas(testCluster(), "a:8983_solr")
+
+	var names []string
+	for _, replica := range replicas {
+		names = append(names, replica.String())
+	}
+	assert.Equal(t, []string{"products/shard1/core_node1", "products/shard2/core_node3"}, names)
+}
+
+func TestLeastLoadedNode(t *testing.T) {
+	cluster := testCluster()
+	replicas := nodeReplicas(cluster, "a:8983_solr")
+
+	// shard1 already has a replica on b, so c is the only candidate.
+	node, err := leastLoadedNode(cluster, replicas[0], "a:8983_solr")
+	assert.NoError(t, err)
+	assert.Equal(t, "c:8983_solr", node)
+
+	// shard2 already has a replica on c, so b is the only candidate.
+	node, err = leastLoadedNode(cluster, replicas[1], "a:8983_solr")
+	assert.NoError(t, err)
+	assert.Equal(t, "b:8983_solr", node)
+
+	cluster.LiveNodes = []string{"a:8983_solr", "b:8983_solr"}
+	_, err = leastLoadedNode(cluster, replicas[0], "a:8983_solr")
+	assert.Error(t, err)
+}
+
+func TestNodeBaseURL(t *testing.T) {
+	cluster := testCluster()
+
+	assert.Equal(t, "https://b:8983/solr", nodeBaseURL(cluster, "b:8983_solr", "https://solr.example.com"))
+	assert.Equal(t, "http://d:8983/solr", nodeBaseURL(cluster, "d:8983_solr", "solr.example.com"))
+
+	replica := cluster.Collections["products"].Shards["shard1"].Replicas["core_node1"]
+	replica.BaseURL = "http://a.internal:8983/solr"
+	cluster.Collections["products"].Shards["shard1"].Replicas["core_node1"] = replica
+	assert.Equal(t, "http://a.internal:8983/solr", nodeBaseURL(cluster, "a:8983_solr", "https://solr.example.com"))
+}
@@ -0,0 +1,346 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// preferredLeaderProperty is the replica property REBALANCELEADERS honours.
+const preferredLeaderProperty = "preferredLeader"
+
+var (
+	_ resource.Resource                   = &preferredLeaderResource{}
+	_ resource.ResourceWithConfigure      = &preferredLeaderResource{}
+	_ resource.ResourceWithValidateConfig = &preferredLeaderResource{}
+)
+
+// NewPreferredLeaderResource is a helper function to simplify the provider implementation.
+func NewPreferredLeaderResource() resource.Resource {
+	return &preferredLeaderResource{}
+}
+
+// preferredLeaderResource manages the preferredLeader property of a collection's replicas.
+type preferredLeaderResource struct {
+	client Client
+}
+
+// PreferredLeaderResourceModel is the model for the solrcloud_preferred_leader resource.
+type PreferredLeaderResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	Collection       types.String   `tfsdk:"collection"`
+	Replicas         types.Map      `tfsdk:"replicas"`
+	Balance          types.Bool     `tfsdk:"balance"`
+	RebalanceLeaders types.Bool     `tfsdk:"rebalance_leaders"`
+	PreferredLeaders types.Map      `tfsdk:"preferred_leaders"`
+	Leaders          types.Map      `tfsdk:"leaders"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *preferredLeaderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = *client
+}
+
+// Schema defines the schema for the resource.
+func (r *preferredLeaderResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the preferredLeader replica property of a collection and optionally rebalances shard leaders onto it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the collection.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collection": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the collection.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"replicas": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The preferred leader replica (core_node name) keyed by shard name. Conflicts with balance.",
+			},
+			"balance": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Spread the preferredLeader property evenly across nodes with BALANCESHARDUNIQUE. Conflicts with replicas.",
+			},
+			"rebalance_leaders": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Run REBALANCELEADERS after the preferred leaders are set.",
+			},
+			"preferred_leaders": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The replica carrying the preferredLeader property, keyed by shard name.",
+			},
+			"leaders": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The current leader replica of each active shard, keyed by shard name.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *preferredLeaderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_preferred_leader"
+}
+
+// ValidateConfig ensures exactly one way of choosing preferred leaders is configured.
+func (r *preferredLeaderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config PreferredLeaderResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Replicas.IsNull() && config.Balance.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("balance"),
+			"Conflicting Preferred Leader Configuration",
+			"Either set replicas explicitly or enable balance, not both.",
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *preferredLeaderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PreferredLeaderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts, "create", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	replicas := map[string]string{}
+	resp.Diagnostics.Append(plan.Replicas.ElementsAs(ctx, &replicas, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &plan, nil, replicas); err != nil {
+		addClientError(&resp.Diagnostics, "Error setting preferred leaders", "Could not set preferred leaders, unexpected error: ", err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.Collection.ValueString())
+	resp.Diagnostics.Append(r.refresh(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *preferredLeaderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PreferredLeaderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, state.Timeouts, "read", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.refresh(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.Replicas.IsNull() {
+		configured := map[string]string{}
+		resp.Diagnostics.Append(state.Replicas.ElementsAs(ctx, &configured, true)...)
+		actual := map[string]string{}
+		resp.Diagnostics.Append(state.PreferredLeaders.ElementsAs(ctx, &actual, true)...)
+		for shard := range configured {
+			configured[shard] = actual[shard]
+		}
+		replicas, d := types.MapValueFrom(ctx, types.StringType, configured)
+		resp.Diagnostics.Append(d...)
+		state.Replicas = replicas
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *preferredLeaderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state PreferredLeaderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts, "update", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previous := map[string]string{}
+	resp.Diagnostics.Append(state.Replicas.ElementsAs(ctx, &previous, true)...)
+	replicas := map[string]string{}
+	resp.Diagnostics.Append(plan.Replicas.ElementsAs(ctx, &replicas, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &plan, previous, replicas); err != nil {
+		addClientError(&resp.Diagnostics, "Error setting preferred leaders", "Could not set preferred leaders, unexpected error: ", err)
+		return
+	}
+
+	resp.Diagnostics.Append(r.refresh(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the preferredLeader property from the replicas this resource set.
+func (r *preferredLeaderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PreferredLeaderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, state.Timeouts, "delete", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	preferred := map[string]string{}
+	resp.Diagnostics.Append(state.PreferredLeaders.ElementsAs(ctx, &preferred, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for shard, replica := range preferred {
+		err := r.client.DeleteReplicaProperty(ctx, state.Collection.ValueString(), shard, replica, preferredLeaderProperty)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error removing preferred leader",
+				fmt.Sprintf("Could not remove preferredLeader from replica %s of shard %s: %s", replica, shard, err),
+			)
+		}
+	}
+}
+
+// apply moves the preferredLeader property from the previous replicas to the
+// planned ones, or balances it, and then rebalances leaders if requested.
+func (r *preferredLeaderResource) apply(ctx context.Context, plan *PreferredLeaderResourceModel, previous, replicas map[string]string) error {
+	collection := plan.Collection.ValueString()
+
+	for shard, replica := range previous {
+		if _, ok := replicas[shard]; ok {
+			continue
+		}
+		if err := r.client.DeleteReplicaProperty(ctx, collection, shard, replica, preferredLeaderProperty); err != nil {
+			return err
+		}
+	}
+
+	for shard, replica := range replicas {
+		if err := r.client.AddReplicaProperty(ctx, collection, shard, replica, preferredLeaderProperty, "true"); err != nil {
+			return err
+		}
+	}
+
+	if plan.Balance.ValueBool() {
+		if err := r.client.BalanceShardUnique(ctx, collection, preferredLeaderProperty); err != nil {
+			return err
+		}
+	}
+
+	if plan.RebalanceLeaders.ValueBool() {
+		return r.client.RebalanceLeaders(ctx, collection)
+	}
+
+	return nil
+}
+
+// refresh populates the computed leader attributes from CLUSTERSTATUS.
+func (r *preferredLeaderResource) refresh(ctx context.Context, model *PreferredLeaderResourceModel) (diags diag.Diagnostics) {
+	collection, err := r.client.GetCollectionStatus(ctx, model.Collection.ValueString())
+	if err != nil {
+		addClientError(&diags, "Error reading collection", "Could not read collection "+model.Collection.ValueString()+": ", err)
+		return diags
+	}
+
+	preferred := map[string]string{}
+	leaders := map[string]string{}
+	for _, shard := range activeShards(collection) {
+		for name, replica := range collection.Shards[shard].Replicas {
+			if replica.PreferredLeader == "true" {
+				preferred[shard] = name
+			}
+			if replica.Leader == "true" {
+				leaders[shard] = name
+			}
+		}
+	}
+
+	var d diag.Diagnostics
+	model.PreferredLeaders, d = types.MapValueFrom(ctx, types.StringType, preferred)
+	diags.Append(d...)
+	model.Leaders, d = types.MapValueFrom(ctx, types.StringType, leaders)
+	diags.Append(d...)
+
+	return diags
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPreferredLeaderServer serves a Solr 7 cluster with the given replicas
+// of shard1 and shard2 of films, and records every Collections API call
+// other than CLUSTERSTATUS.
+func testPreferredLeaderServer(t *testing.T, shards string, calls *[]url.Values) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/solr/admin/info/system" {
+			fmt.Fprint(w, `{"lucene":{"solr-spec-version":"7.7.3"}}`)
+			return
+		}
+
+		switch r.URL.Query().Get("action") {
+		case "CLUSTERSTATUS":
+			fmt.Fprintf(w, `{"cluster":{"collections":{"films":{"shards":{%s}}}}}`, shards)
+		case "ADDREPLICAPROP", "DELETEREPLICAPROP", "BALANCESHARDUNIQUE", "REBALANCELEADERS":
+			*calls = append(*calls, r.URL.Query())
+			fmt.Fprint(w, `{"responseHeader":{"status":0}}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+		}
+	}))
+}
+
+// preferredLeaderValue returns a solrcloud_preferred_leader object with the
+// given attributes set and every other attribute null.
+func preferredLeaderValue(schema resource.SchemaResponse, attributes map[string]tftypes.Value) tftypes.Value {
+	objectType := schema.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	return testObject(objectType, attributes)
+}
+
+// stringMapValue returns a Terraform map of strings.
+func stringMapValue(values map[string]string) tftypes.Value {
+	elements := map[string]tftypes.Value{}
+	for key, value := range values {
+		elements[key] = tftypes.NewValue(tftypes.String, value)
+	}
+	return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, elements)
+}
+
+func TestPreferredLeaderResourceCreate(t *testing.T) {
+	var calls []url.Values
+	server := testPreferredLeaderServer(t,
+		`"shard1":{"state":"active","replicas":{
+			"core_node1":{"leader":"true"},
+			"core_node3":{"property.preferredleader":"true"}
+		}}`,
+		&calls,
+	)
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{Host: server.URL})
+	require.NoError(t, err)
+	r := &preferredLeaderResource{client: *client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw: preferredLeaderValue(schemaResp, map[string]tftypes.Value{
+			"collection":        tftypes.NewValue(tftypes.String, "films"),
+			"replicas":          stringMapValue(map[string]string{"shard1": "core_node3"}),
+			"balance":           tftypes.NewValue(tftypes.Bool, false),
+			"rebalance_leaders": tftypes.NewValue(tftypes.Bool, true),
+		}),
+	}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: preferredLeaderValue(schemaResp, nil)}}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	require.Len(t, calls, 2)
+	assert.Equal(t, url.Values{
+		"action":         {"ADDREPLICAPROP"},
+		"collection":     {"films"},
+		"shard":          {"shard1"},
+		"replica":        {"core_node3"},
+		"property":       {"preferredLeader"},
+		"property.value": {"true"},
+		"wt":             {"json"},
+	}, calls[0])
+	assert.Equal(t, "REBALANCELEADERS", calls[1].Get("action"))
+	assert.Equal(t, "films", calls[1].Get("collection"))
+
+	var state PreferredLeaderResourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	assert.Equal(t, "films", state.ID.ValueString())
+	assert.Equal(t, `{"shard1":"core_node3"}`, state.PreferredLeaders.String())
+	assert.Equal(t, `{"shard1":"core_node1"}`, state.Leaders.String())
+}
+
+// TestPreferredLeaderResourceReadDrift checks that preferred leaders moved
+// or removed outside Terraform show up in replicas so that they are planned
+// back.
+func TestPreferredLeaderResourceReadDrift(t *testing.T) {
+	var calls []url.Values
+	server := testPreferredLeaderServer(t,
+		`"shard1":{"state":"active","replicas":{
+			"core_node3":{"leader":"true"},
+			"core_node4":{"property.preferredleader":"true"}
+		}},
+		"shard2":{"state":"active","replicas":{
+			"core_node5":{"leader":"true"}
+		}}`,
+		&calls,
+	)
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{Host: server.URL})
+	require.NoError(t, err)
+	r := &preferredLeaderResource{client: *client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw: preferredLeaderValue(schemaResp, map[string]tftypes.Value{
+			"id":                tftypes.NewValue(tftypes.String, "films"),
+			"collection":        tftypes.NewValue(tftypes.String, "films"),
+			"replicas":          stringMapValue(map[string]string{"shard1": "core_node3", "shard2": "core_node5"}),
+			"balance":           tftypes.NewValue(tftypes.Bool, false),
+			"rebalance_leaders": tftypes.NewValue(tftypes.Bool, false),
+			"preferred_leaders": stringMapValue(map[string]string{"shard1": "core_node3", "shard2": "core_node5"}),
+			"leaders":           stringMapValue(map[string]string{"shard1": "core_node3", "shard2": "core_node5"}),
+		}),
+	}
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Empty(t, calls)
+
+	var refreshed PreferredLeaderResourceModel
+	require.False(t, resp.State.Get(context.Background(), &refreshed).HasError())
+	assert.Equal(t, `{"shard1":"core_node4","shard2":""}`, refreshed.Replicas.String())
+	assert.Equal(t, `{"shard1":"core_node4"}`, refreshed.PreferredLeaders.String())
+	assert.Equal(t, `{"shard1":"core_node3","shard2":"core_node5"}`, refreshed.Leaders.String())
+}
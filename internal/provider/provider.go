@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -24,8 +26,36 @@ type SolrCloudProvider struct {
 // SolrCloudProviderModel describes the provider data model.
 type solrCloudProviderModel struct {
 	Host     types.String `tfsdk:"host"`
+	Hosts    types.List   `tfsdk:"hosts"`
+	ZKHosts  types.List   `tfsdk:"zk_hosts"`
+	ZKChroot types.String `tfsdk:"zk_chroot"`
 	Username types.String `tfsdk:"username"`
 	Password types.String `tfsdk:"password"`
+	Auth     types.String `tfsdk:"auth"`
+
+	Token     types.String                  `tfsdk:"token"`
+	TokenFile types.String                  `tfsdk:"token_file"`
+	OAuth2    *solrCloudOAuth2ProviderModel `tfsdk:"oauth2"`
+
+	CACert             types.String `tfsdk:"ca_cert"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ServerName         types.String `tfsdk:"server_name"`
+
+	RequestTimeout types.String `tfsdk:"request_timeout"`
+	LogRequestBody types.Bool   `tfsdk:"log_request_body"`
+	MaxRetries     types.Int64  `tfsdk:"max_retries"`
+	MinBackoff     types.String `tfsdk:"min_backoff"`
+	MaxBackoff     types.String `tfsdk:"max_backoff"`
+}
+
+// solrCloudOAuth2ProviderModel describes the oauth2 block of the provider.
+type solrCloudOAuth2ProviderModel struct {
+	TokenURL     types.String `tfsdk:"token_url"`
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	Scopes       types.List   `tfsdk:"scopes"`
 }
 
 func (p *SolrCloudProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -38,7 +68,21 @@ func (p *SolrCloudProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
 				MarkdownDescription: "The hostname of the SolrCloud API",
-				Required:            true,
+				Optional:            true,
+			},
+			"hosts": schema.ListAttribute{
+				MarkdownDescription: "Additional SolrCloud nodes. Requests are spread round-robin over `host` and `hosts`, and fail over to another node when one is unreachable. Once the cluster has been contacted, the live nodes it reports are preferred.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"zk_hosts": schema.ListAttribute{
+				MarkdownDescription: "The ZooKeeper ensemble of the cluster as `host:port` pairs. The live Solr nodes are discovered from `/live_nodes`, like SolrJ's CloudSolrClient does, and requests are spread over them. Ensembles that require SASL authentication or TLS are not supported; use `hosts` for them.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"zk_chroot": schema.StringAttribute{
+				MarkdownDescription: "The ZooKeeper chroot of the cluster, such as `/solr`. Defaults to the ZooKeeper root.",
+				Optional:            true,
 			},
 			"username": schema.StringAttribute{
 				MarkdownDescription: "The username for SolrCloud API authentication",
@@ -49,11 +93,105 @@ func (p *SolrCloudProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"auth": schema.StringAttribute{
+				MarkdownDescription: "The authentication mode: `basic`, `bearer` or `none`. Defaults to `bearer` when a token, token file or oauth2 block is set, `basic` when a username and password are set and `none` otherwise.",
+				Optional:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "A static bearer token (JWT) for SolrCloud API authentication. Conflicts with `token_file` and `oauth2`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"token_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file holding the bearer token. The file is read again whenever the token expires. Conflicts with `token` and `oauth2`.",
+				Optional:            true,
+			},
+			"oauth2": schema.SingleNestedAttribute{
+				MarkdownDescription: "Obtain bearer tokens with the OAuth2 client credentials flow. Conflicts with `token` and `token_file`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"token_url": schema.StringAttribute{
+						MarkdownDescription: "The token endpoint of the identity provider.",
+						Required:            true,
+					},
+					"client_id": schema.StringAttribute{
+						MarkdownDescription: "The OAuth2 client ID.",
+						Required:            true,
+					},
+					"client_secret": schema.StringAttribute{
+						MarkdownDescription: "The OAuth2 client secret.",
+						Required:            true,
+						Sensitive:           true,
+					},
+					"scopes": schema.ListAttribute{
+						MarkdownDescription: "The scopes to request.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+			"ca_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificates, or the path to a PEM file, used to verify the SolrCloud API certificate. Defaults to the system roots.",
+				Optional:            true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate, or the path to a PEM file, for mutual TLS. Requires `client_key`.",
+				Optional:            true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client private key, or the path to a PEM file, for mutual TLS. Requires `client_cert`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip verification of the SolrCloud API certificate. Only use this for testing.",
+				Optional:            true,
+			},
+			"server_name": schema.StringAttribute{
+				MarkdownDescription: "The server name to verify the SolrCloud API certificate against, if it differs from the host name.",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long a single request to the SolrCloud API may take, such as `30s`. Defaults to `10s`. Use the `timeouts` block of a resource to bound a whole operation.",
+				Optional:            true,
+			},
+			"log_request_body": schema.BoolAttribute{
+				MarkdownDescription: "Log request bodies, with credentials redacted, at the TRACE log level. Defaults to `true`. Requests themselves are logged at the DEBUG level with credentials redacted.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "How often a request that failed with a network error, 429, 502, 503 or an expired ZooKeeper session is retried. Defaults to `3`; `0` disables retries. Requests that are not idempotent are only retried when Solr cannot run them twice.",
+				Optional:            true,
+			},
+			"min_backoff": schema.StringAttribute{
+				MarkdownDescription: "The delay before the first retry, such as `500ms`. The delay doubles with every retry, with jitter. Defaults to `500ms`.",
+				Optional:            true,
+			},
+			"max_backoff": schema.StringAttribute{
+				MarkdownDescription: "The longest delay between retries, such as `10s`. Defaults to `10s`.",
+				Optional:            true,
+			},
 		},
 	}
 }
 
 func (p *SolrCloudProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	// An unknown oauth2 block cannot be read into the model, so it is
+	// reported before the configuration is read.
+	var oauth2Block types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("oauth2"), &oauth2Block)...)
+	if oauth2Block.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("oauth2"),
+			"Unknown SolrCloud OAuth2 Setting",
+			"The provider cannot create the SolrCloud API client as there is an unknown configuration value for oauth2. "+
+				"Either target apply the source of the value first, or set the value statically in the configuration.",
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve provider data from configuration
 	var config solrCloudProviderModel
 	diags := req.Config.Get(ctx, &config)
@@ -74,6 +212,24 @@ func (p *SolrCloudProvider) Configure(ctx context.Context, req provider.Configur
 		)
 	}
 
+	if config.Hosts.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("hosts"),
+			"Unknown SolrCloud API Hosts",
+			"The provider cannot create the SolrCloud API client as there is an unknown configuration value for the SolrCloud API hosts. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SOLRCLOUD_HOSTS environment variable.",
+		)
+	}
+
+	if config.ZKHosts.IsUnknown() || config.ZKChroot.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("zk_hosts"),
+			"Unknown SolrCloud ZooKeeper Hosts",
+			"The provider cannot create the SolrCloud API client as there is an unknown configuration value for the SolrCloud ZooKeeper hosts or chroot. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SOLRCLOUD_ZK_HOSTS and SOLRCLOUD_ZK_CHROOT environment variables.",
+		)
+	}
+
 	if config.Username.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("username"),
@@ -92,18 +248,99 @@ func (p *SolrCloudProvider) Configure(ctx context.Context, req provider.Configur
 		)
 	}
 
+	if config.Auth.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth"),
+			"Unknown SolrCloud API Authentication Mode",
+			"The provider cannot create the SolrCloud API client as there is an unknown configuration value for the SolrCloud API authentication mode. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SOLRCLOUD_AUTH environment variable.",
+		)
+	}
+
+	if config.Token.IsUnknown() || config.TokenFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token"),
+			"Unknown SolrCloud API Token",
+			"The provider cannot create the SolrCloud API client as there is an unknown configuration value for the SolrCloud API token or token file. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SOLRCLOUD_TOKEN or SOLRCLOUD_TOKEN_FILE environment variable.",
+		)
+	}
+
+	type setting struct {
+		path    path.Path
+		summary string
+		unknown bool
+	}
+	settings := []setting{
+		{path.Root("ca_cert"), "Unknown SolrCloud TLS Setting", config.CACert.IsUnknown()},
+		{path.Root("client_cert"), "Unknown SolrCloud TLS Setting", config.ClientCert.IsUnknown()},
+		{path.Root("client_key"), "Unknown SolrCloud TLS Setting", config.ClientKey.IsUnknown()},
+		{path.Root("server_name"), "Unknown SolrCloud TLS Setting", config.ServerName.IsUnknown()},
+		{path.Root("insecure_skip_verify"), "Unknown SolrCloud TLS Setting", config.InsecureSkipVerify.IsUnknown()},
+		{path.Root("request_timeout"), "Unknown SolrCloud Request Setting", config.RequestTimeout.IsUnknown()},
+		{path.Root("max_retries"), "Unknown SolrCloud Request Setting", config.MaxRetries.IsUnknown()},
+		{path.Root("min_backoff"), "Unknown SolrCloud Request Setting", config.MinBackoff.IsUnknown()},
+		{path.Root("max_backoff"), "Unknown SolrCloud Request Setting", config.MaxBackoff.IsUnknown()},
+		{path.Root("log_request_body"), "Unknown SolrCloud Request Setting", config.LogRequestBody.IsUnknown()},
+	}
+	if config.OAuth2 != nil {
+		oauth2Path := path.Root("oauth2")
+		settings = append(settings, []setting{
+			{oauth2Path.AtName("token_url"), "Unknown SolrCloud OAuth2 Setting", config.OAuth2.TokenURL.IsUnknown()},
+			{oauth2Path.AtName("client_id"), "Unknown SolrCloud OAuth2 Setting", config.OAuth2.ClientID.IsUnknown()},
+			{oauth2Path.AtName("client_secret"), "Unknown SolrCloud OAuth2 Setting", config.OAuth2.ClientSecret.IsUnknown()},
+			{oauth2Path.AtName("scopes"), "Unknown SolrCloud OAuth2 Setting", config.OAuth2.Scopes.IsUnknown()},
+		}...)
+	}
+	for _, setting := range settings {
+		if setting.unknown {
+			resp.Diagnostics.AddAttributeError(
+				setting.path,
+				setting.summary,
+				"The provider cannot create the SolrCloud API client as there is an unknown configuration value for "+setting.path.String()+". "+
+					"Either target apply the source of the value first, or set the value statically in the configuration.",
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	host := os.Getenv("SOLRCLOUD_HOST")
+	var hosts []string
+	if env := os.Getenv("SOLRCLOUD_HOSTS"); env != "" {
+		hosts = strings.Split(env, ",")
+	}
+	var zkHosts []string
+	if env := os.Getenv("SOLRCLOUD_ZK_HOSTS"); env != "" {
+		zkHosts = strings.Split(env, ",")
+	}
+	zkChroot := os.Getenv("SOLRCLOUD_ZK_CHROOT")
 	username := os.Getenv("SOLCLOUD_USERNAME")
 	password := os.Getenv("SOLCLOUD_PASSWORD")
+	auth := os.Getenv("SOLRCLOUD_AUTH")
+	token := os.Getenv("SOLRCLOUD_TOKEN")
+	tokenFile := os.Getenv("SOLRCLOUD_TOKEN_FILE")
 
 	if !config.Host.IsNull() {
 		host = config.Host.ValueString()
 	}
 
+	if !config.Hosts.IsNull() {
+		hosts = nil
+		resp.Diagnostics.Append(config.Hosts.ElementsAs(ctx, &hosts, false)...)
+	}
+
+	if !config.ZKHosts.IsNull() {
+		zkHosts = nil
+		resp.Diagnostics.Append(config.ZKHosts.ElementsAs(ctx, &zkHosts, false)...)
+	}
+
+	if !config.ZKChroot.IsNull() {
+		zkChroot = config.ZKChroot.ValueString()
+	}
+
 	if !config.Username.IsNull() {
 		username = config.Username.ValueString()
 	}
@@ -112,36 +349,168 @@ func (p *SolrCloudProvider) Configure(ctx context.Context, req provider.Configur
 		password = config.Password.ValueString()
 	}
 
+	if !config.Auth.IsNull() {
+		auth = config.Auth.ValueString()
+	}
+
+	if !config.Token.IsNull() {
+		token = config.Token.ValueString()
+	}
+
+	if !config.TokenFile.IsNull() {
+		tokenFile = config.TokenFile.ValueString()
+	}
+
+	var oauth2 *OAuth2Config
+	if config.OAuth2 != nil {
+		oauth2 = &OAuth2Config{
+			TokenURL:     config.OAuth2.TokenURL.ValueString(),
+			ClientID:     config.OAuth2.ClientID.ValueString(),
+			ClientSecret: config.OAuth2.ClientSecret.ValueString(),
+		}
+		resp.Diagnostics.Append(config.OAuth2.Scopes.ElementsAs(ctx, &oauth2.Scopes, true)...)
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
-	if host == "" {
+	if host == "" && len(hosts) == 0 && len(zkHosts) == 0 {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("host"),
 			"Missing SolrCloud API Host",
 			"The provider cannot create the SolrCloud API client as there is a missing or empty value for the SolrCloud API host. "+
-				"Set the host value in the configuration or use the SolrCLOUD_HOST environment variable. "+
+				"Set the host, hosts or zk_hosts value in the configuration or use the SolrCLOUD_HOST, SOLRCLOUD_HOSTS or SOLRCLOUD_ZK_HOSTS environment variable. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
-	if username == "" {
+	tokenSources := 0
+	for _, set := range []bool{token != "", tokenFile != "", oauth2 != nil} {
+		if set {
+			tokenSources++
+		}
+	}
+
+	if auth == "" {
+		auth = "none"
+		if username != "" || password != "" {
+			auth = "basic"
+		}
+		if tokenSources > 0 {
+			auth = "bearer"
+		}
+	}
+
+	if auth != "bearer" {
+		token, tokenFile, oauth2 = "", "", nil
+	}
+
+	switch auth {
+	case "none":
+		if !config.Username.IsNull() || !config.Password.IsNull() {
+			resp.Diagnostics.AddWarning(
+				"SolrCloud API Credentials Ignored",
+				"The provider is configured with auth = \"none\", so the configured username and password are not sent to the SolrCloud API.",
+			)
+		}
+		username, password = "", ""
+	case "basic":
+		if username == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("username"),
+				"Missing SolrCloud API Username",
+				"The provider cannot create the SolrCloud API client as a password is set but the username is missing or empty. "+
+					"Set the username value in the configuration or use the SOLCLOUD_USERNAME environment variable, "+
+					"or remove the password to connect without authentication.",
+			)
+		}
+
+		if password == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("password"),
+				"Missing SolrCloud API Password",
+				"The provider cannot create the SolrCloud API client as a username is set but the password is missing or empty. "+
+					"Set the password value in the configuration or use the SOLCLOUD_PASSWORD environment variable, "+
+					"or remove the username to connect without authentication.",
+			)
+		}
+	case "bearer":
+		switch {
+		case tokenSources == 0:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token"),
+				"Missing SolrCloud API Token",
+				"The provider cannot create the SolrCloud API client as auth = \"bearer\" but no token is configured. "+
+					"Set token, token_file or the oauth2 block in the configuration, or use the SOLRCLOUD_TOKEN or SOLRCLOUD_TOKEN_FILE environment variable.",
+			)
+		case tokenSources > 1:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token"),
+				"Conflicting SolrCloud API Tokens",
+				"The provider cannot create the SolrCloud API client as more than one of token, token_file and oauth2 is set. "+
+					"Set exactly one of them.",
+			)
+		}
+		username, password = "", ""
+	default:
 		resp.Diagnostics.AddAttributeError(
-			path.Root("username"),
-			"Missing SolrCloud API Username",
-			"The provider cannot create the SolrCloud API client as there is a missing or empty value for the SolrCloud API username. "+
-				"Set the username value in the configuration or use the SOLCLOUD_USERNAME environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+			path.Root("auth"),
+			"Invalid SolrCloud API Authentication Mode",
+			"The auth value must be one of \"basic\", \"bearer\" or \"none\", got \""+auth+"\".",
 		)
 	}
 
-	if password == "" {
+	retry := RetryConfig{MaxRetries: int(config.MaxRetries.ValueInt64())}
+	if !config.MaxRetries.IsNull() && retry.MaxRetries <= 0 {
+		// RetryConfig treats zero as the default and negative as disabled.
+		retry.MaxRetries = -1
+	}
+
+	var requestTimeout time.Duration
+	if !config.RequestTimeout.IsNull() && !config.RequestTimeout.IsUnknown() {
+		var err error
+		requestTimeout, err = time.ParseDuration(config.RequestTimeout.ValueString())
+		if err != nil || requestTimeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid SolrCloud API Request Timeout",
+				"The request_timeout value must be a positive duration such as \"30s\", got \""+config.RequestTimeout.ValueString()+"\".",
+			)
+		}
+	}
+
+	backoffs := []struct {
+		attribute string
+		value     types.String
+		target    *time.Duration
+	}{
+		{"min_backoff", config.MinBackoff, &retry.MinBackoff},
+		{"max_backoff", config.MaxBackoff, &retry.MaxBackoff},
+	}
+	for _, backoff := range backoffs {
+		attribute, value := backoff.attribute, backoff.value
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		duration, err := time.ParseDuration(value.ValueString())
+		if err != nil || duration <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Invalid SolrCloud API Retry Backoff",
+				"The "+attribute+" value must be a positive duration such as \"500ms\" or \"10s\", got \""+value.ValueString()+"\".",
+			)
+			continue
+		}
+		*backoff.target = duration
+	}
+
+	if config.ClientCert.IsNull() != config.ClientKey.IsNull() {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("password"),
-			"Missing SolrCloud API Password",
-			"The provider cannot create the SolrCloud API client as there is a missing or empty value for the SolrCloud API password. "+
-				"Set the password value in the configuration or use the SOLCLOUD_PASSWORD environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+			path.Root("client_key"),
+			"Incomplete SolrCloud API Client Certificate",
+			"The provider cannot create the SolrCloud API client as only one of client_cert and client_key is set. "+
+				"Set both to use mutual TLS, or neither.",
 		)
 	}
 
@@ -153,10 +522,34 @@ func (p *SolrCloudProvider) Configure(ctx context.Context, req provider.Configur
 	ctx = tflog.SetField(ctx, "solrcloud_username", username)
 	ctx = tflog.SetField(ctx, "solrcloud_password", password)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "solrcloud_password")
+	if token != "" {
+		ctx = tflog.MaskAllFieldValuesStrings(ctx, token)
+	}
 
 	tflog.Debug(ctx, "Creating HashiCups client")
 
-	client, err := NewClient(&host, &username, &password)
+	client, err := NewClient(ClientConfig{
+		Host:           host,
+		Hosts:          hosts,
+		ZKHosts:        zkHosts,
+		ZKChroot:       zkChroot,
+		Username:       username,
+		Password:       password,
+		Token:          token,
+		TokenFile:      tokenFile,
+		OAuth2:         oauth2,
+		RequestTimeout: requestTimeout,
+		Retry:          retry,
+
+		DisableBodyLogging: !config.LogRequestBody.IsNull() && !config.LogRequestBody.ValueBool(),
+		TLS: TLSConfig{
+			CACert:             config.CACert.ValueString(),
+			ClientCert:         config.ClientCert.ValueString(),
+			ClientKey:          config.ClientKey.ValueString(),
+			InsecureSkipVerify: config.InsecureSkipVerify.ValueBool(),
+			ServerName:         config.ServerName.ValueString(),
+		},
+	})
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -175,12 +568,25 @@ func (p *SolrCloudProvider) Configure(ctx context.Context, req provider.Configur
 func (p *SolrCloudProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewCollectionResource,
+		NewShardResource,
+		NewReplicaResource,
+		NewPreferredLeaderResource,
+		NewCollectionBackupResource,
+		NewNodeDrainResource,
 	}
 }
 
 func (p *SolrCloudProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewCollectionsDataSource,
+		NewCollectionBackupsDataSource,
+		NewClusterStatusDataSource,
+		NewCollectionDataSource,
+		NewAliasesDataSource,
+		NewNodesDataSource,
+		NewSystemDataSource,
+		NewMetricsDataSource,
+		NewHealthDataSource,
 	}
 }
 
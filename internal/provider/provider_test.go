@@ -6,40 +6,198 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
-	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
-// TestProvider instantiates the provider with default values and asserts that it is valid.
-func TestProvider(t *testing.T) {
+// unknownAttribute marks an attribute passed to configureProvider as unknown.
+const unknownAttribute = "<unknown>"
+
+// configureProvider runs Configure with the given provider attributes; unset
+// attributes are null.
+func configureProvider(t *testing.T, attributes map[string]string) provider.ConfigureResponse {
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range providerObjectType().AttributeTypes {
+		if value, ok := attributes[name]; ok && value == unknownAttribute {
+			values[name] = tftypes.NewValue(attributeType, tftypes.UnknownValue)
+		} else if ok {
+			values[name] = tftypes.NewValue(attributeType, value)
+		}
+	}
+	return configureProviderValues(t, values)
+}
+
+// configureProviderValues runs Configure with the given provider attribute
+// values; unset attributes are null.
+func configureProviderValues(t *testing.T, values map[string]tftypes.Value) provider.ConfigureResponse {
+	t.Setenv("SOLRCLOUD_HOST", "")
+	t.Setenv("SOLRCLOUD_HOSTS", "")
+	t.Setenv("SOLRCLOUD_ZK_HOSTS", "")
+	t.Setenv("SOLRCLOUD_ZK_CHROOT", "")
+	t.Setenv("SOLCLOUD_USERNAME", "")
+	t.Setenv("SOLCLOUD_PASSWORD", "")
+	t.Setenv("SOLRCLOUD_AUTH", "")
+	t.Setenv("SOLRCLOUD_TOKEN", "")
+	t.Setenv("SOLRCLOUD_TOKEN_FILE", "")
+
 	testProv := New("test")()
-	_, diags := testProv.(provider.Provider).Configure(context.Background(), provider.ConfigureRequest{
-		Config: tfsdk.NewValue(map[string]tfsdk.Value{
-			"solrcloud_endpoint": tfsdk.Value{
-				Type:  types.StringType,
-				Value: types.String{Value: "http://localhost:8983/solr"},
-			},
-		}),
+
+	schemaResp := &provider.SchemaResponse{}
+	testProv.Schema(context.Background(), provider.SchemaRequest{}, schemaResp)
+
+	resp := provider.ConfigureResponse{}
+	testProv.Configure(context.Background(), provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    testObject(providerObjectType(), values),
+		},
+	}, &resp)
+
+	return resp
+}
+
+// providerObjectType returns the Terraform type of the provider configuration.
+func providerObjectType() tftypes.Object {
+	schemaResp := &provider.SchemaResponse{}
+	New("test")().Schema(context.Background(), provider.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+}
+
+// testObject returns a value of objectType with the given attributes set and
+// every other attribute null.
+func testObject(objectType tftypes.Object, attributes map[string]tftypes.Value) tftypes.Value {
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		if value, ok := attributes[name]; ok {
+			values[name] = value
+		} else {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+	return tftypes.NewValue(objectType, values)
+}
+
+// TestProvider configures the provider with only a host and asserts that it is valid.
+func TestProvider(t *testing.T) {
+	resp := configureProvider(t, map[string]string{
+		"host": "http://localhost:8983",
 	})
 
-	assert.False(t, diags.HasError())
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.IsType(t, &Client{}, resp.ResourceData)
+}
+
+// TestProviderAuth checks that username and password must be set together.
+func TestProviderAuth(t *testing.T) {
+	resp := configureProvider(t, map[string]string{
+		"host":     "http://localhost:8983",
+		"username": "solr",
+		"password": "SolrRocks",
+	})
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	resp = configureProvider(t, map[string]string{
+		"host":     "http://localhost:8983",
+		"username": "solr",
+	})
+	assert.True(t, resp.Diagnostics.HasError())
+
+	resp = configureProvider(t, map[string]string{
+		"host":     "http://localhost:8983",
+		"username": "solr",
+		"auth":     "none",
+	})
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Nil(t, resp.ResourceData.(*Client).auth)
+
+	resp = configureProvider(t, map[string]string{
+		"host": "http://localhost:8983",
+		"auth": "kerberos",
+	})
+	assert.True(t, resp.Diagnostics.HasError())
+
+	resp = configureProvider(t, map[string]string{
+		"host":  "http://localhost:8983",
+		"token": "secret",
+	})
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.NotNil(t, resp.ResourceData.(*Client).auth)
+
+	resp = configureProvider(t, map[string]string{
+		"host":       "http://localhost:8983",
+		"token":      "secret",
+		"token_file": "/run/secrets/solr-token",
+	})
+	assert.True(t, resp.Diagnostics.HasError())
+
+	resp = configureProvider(t, map[string]string{
+		"host": "http://localhost:8983",
+		"auth": "bearer",
+	})
+	assert.True(t, resp.Diagnostics.HasError())
+}
+
+// TestProviderUnknownSettings checks that unknown TLS and request settings are
+// reported instead of being treated as unset.
+func TestProviderUnknownSettings(t *testing.T) {
+	for _, name := range []string{"ca_cert", "client_cert", "client_key", "server_name", "insecure_skip_verify", "request_timeout", "max_retries", "min_backoff", "max_backoff", "log_request_body", "oauth2"} {
+		resp := configureProvider(t, map[string]string{
+			"host": "http://localhost:8983",
+			name:   unknownAttribute,
+		})
+		assert.True(t, resp.Diagnostics.HasError(), name)
+	}
+}
+
+// TestProviderUnknownOAuth2Settings checks that unknown values inside the
+// oauth2 block are reported instead of being read as empty strings.
+func TestProviderUnknownOAuth2Settings(t *testing.T) {
+	oauth2Type := providerObjectType().AttributeTypes["oauth2"].(tftypes.Object)
+	for _, name := range []string{"token_url", "client_id", "client_secret", "scopes"} {
+		oauth2 := map[string]tftypes.Value{
+			"token_url":     tftypes.NewValue(tftypes.String, "https://idp.example.com/token"),
+			"client_id":     tftypes.NewValue(tftypes.String, "terraform"),
+			"client_secret": tftypes.NewValue(tftypes.String, "secret"),
+			"scopes":        tftypes.NewValue(oauth2Type.AttributeTypes["scopes"], nil),
+		}
+		oauth2[name] = tftypes.NewValue(oauth2Type.AttributeTypes[name], tftypes.UnknownValue)
+
+		resp := configureProviderValues(t, map[string]tftypes.Value{
+			"host":   tftypes.NewValue(tftypes.String, "http://localhost:8983"),
+			"oauth2": tftypes.NewValue(oauth2Type, oauth2),
+		})
+		require.True(t, resp.Diagnostics.HasError(), name)
+		assert.Equal(t, "Unknown SolrCloud OAuth2 Setting", resp.Diagnostics.Errors()[0].Summary(), name)
+	}
 }
 
 // TestProviderDataSources checks if the provider declares the expected data sources.
 func TestProviderDataSources(t *testing.T) {
 	testProv := New("test")()
-	dataSources := testProv.(provider.Provider).DataSources(context.Background())
 
-	// Replace "solrcloud_collections" with your actual data source name
-	assert.Contains(t, dataSources, func() datasource.DataSource { return NewSolrCloudCollectionsDataSource() })
+	var names []string
+	for _, dataSource := range testProv.DataSources(context.Background()) {
+		resp := &datasource.MetadataResponse{}
+		dataSource().Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "solrcloud"}, resp)
+		names = append(names, resp.TypeName)
+	}
+
+	assert.Contains(t, names, "solrcloud_collections")
 }
 
 // TestProviderResources checks if the provider declares the expected resources.
 func TestProviderResources(t *testing.T) {
 	testProv := New("test")()
-	resources := testProv.(provider.Provider).Resources(context.Background())
 
-	// Add assertions for your resources here
-	// assert.Contains(t, resources, func() resource.Resource { return NewYourResource() })
+	var names []string
+	for _, r := range testProv.Resources(context.Background()) {
+		resp := &resource.MetadataResponse{}
+		r().Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "solrcloud"}, resp)
+		names = append(names, resp.TypeName)
+	}
+
+	assert.Contains(t, names, "solrcloud_collection")
 }
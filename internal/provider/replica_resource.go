@@ -0,0 +1,343 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &replicaResource{}
+	_ resource.ResourceWithConfigure      = &replicaResource{}
+	_ resource.ResourceWithValidateConfig = &replicaResource{}
+	_ resource.ResourceWithModifyPlan     = &replicaResource{}
+)
+
+// NewReplicaResource is a helper function to simplify the provider implementation.
+func NewReplicaResource() resource.Resource {
+	return &replicaResource{}
+}
+
+// replicaResource manages a single replica of a shard, optionally pinned to a node.
+type replicaResource struct {
+	client Client
+}
+
+// ReplicaResourceModel is the model for the solrcloud_replica resource.
+type ReplicaResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Collection  types.String   `tfsdk:"collection"`
+	Shard       types.String   `tfsdk:"shard"`
+	Node        types.String   `tfsdk:"node"`
+	Type        types.String   `tfsdk:"type"`
+	InstanceDir types.String   `tfsdk:"instance_dir"`
+	DataDir     types.String   `tfsdk:"data_dir"`
+	ReplicaName types.String   `tfsdk:"replica_name"`
+	Core        types.String   `tfsdk:"core"`
+	State       types.String   `tfsdk:"state"`
+	Leader      types.Bool     `tfsdk:"leader"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *replicaResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = *client
+}
+
+// Schema defines the schema for the resource.
+func (r *replicaResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	replace := []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a single replica of a shard. A replica found in the recovery_failed state is replaced on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The replica identifier in the form collection/shard/replica_name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collection": schema.StringAttribute{
+				Required:      true,
+				Description:   "The name of the collection.",
+				PlanModifiers: replace,
+			},
+			"shard": schema.StringAttribute{
+				Required:      true,
+				Description:   "The name of the shard to add the replica to.",
+				PlanModifiers: replace,
+			},
+			"node": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The node to create the replica on, e.g. 10.0.0.1:8983_solr. Solr picks a node when unset.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Default:       stringdefault.StaticString("nrt"),
+				Description:   "The replica type: nrt, tlog or pull.",
+				PlanModifiers: replace,
+			},
+			"instance_dir": schema.StringAttribute{
+				Optional:      true,
+				Description:   "The instanceDir for the replica core.",
+				PlanModifiers: replace,
+			},
+			"data_dir": schema.StringAttribute{
+				Optional:      true,
+				Description:   "The dataDir for the replica core.",
+				PlanModifiers: replace,
+			},
+			"replica_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The core_node name Solr assigned to the replica.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"core": schema.StringAttribute{
+				Computed:    true,
+				Description: "The core name of the replica.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Computed:    true,
+				Description: "The replica state as reported by CLUSTERSTATUS.",
+			},
+			"leader": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the replica is currently the shard leader.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *replicaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replica"
+}
+
+// ValidateConfig checks the replica type.
+func (r *replicaResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var replicaType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &replicaType)...)
+	if replicaType.IsNull() || replicaType.IsUnknown() {
+		return
+	}
+
+	switch replicaType.ValueString() {
+	case "nrt", "tlog", "pull":
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid Replica Type",
+			fmt.Sprintf("The replica type must be one of nrt, tlog or pull, got %q.", replicaType.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan replaces replicas that Solr reports as recovery_failed.
+func (r *replicaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state ReplicaResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.State.ValueString() == "recovery_failed" {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("state"), types.StringUnknown())...)
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("state"))
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *replicaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ReplicaResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts, "create", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name, err := r.client.AddReplica(ctx, ReplicaCreationRequest{
+		Collection:  plan.Collection.ValueString(),
+		Shard:       plan.Shard.ValueString(),
+		Node:        plan.Node.ValueString(),
+		Type:        plan.Type.ValueString(),
+		InstanceDir: plan.InstanceDir.ValueString(),
+		DataDir:     plan.DataDir.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating replica", "Could not add replica, unexpected error: ", err)
+		return
+	}
+
+	replica, ok, err := r.client.GetReplica(ctx, plan.Collection.ValueString(), plan.Shard.ValueString(), name)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading replica", "Could not read replica, unexpected error: ", err)
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error reading replica",
+			fmt.Sprintf("Replica %s of shard %s of collection %s was added but is no longer in the cluster state.", name, plan.Shard.ValueString(), plan.Collection.ValueString()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.Collection.ValueString() + "/" + plan.Shard.ValueString() + "/" + name)
+	plan.ReplicaName = types.StringValue(name)
+	setReplicaState(&plan, replica)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *replicaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ReplicaResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, state.Timeouts, "read", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	replica, ok, err := r.client.GetReplica(ctx, state.Collection.ValueString(), state.Shard.ValueString(), state.ReplicaName.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading replica", "Could not read replica, unexpected error: ", err)
+		return
+	}
+
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setReplicaState(&state, replica)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update refreshes the computed attributes; every configurable attribute
+// requires replacement.
+func (r *replicaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ReplicaResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts, "update", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	replica, ok, err := r.client.GetReplica(ctx, plan.Collection.ValueString(), plan.Shard.ValueString(), plan.ReplicaName.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading replica", "Could not read replica, unexpected error: ", err)
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error reading replica",
+			fmt.Sprintf("Replica %s of shard %s of collection %s is no longer in the cluster state.", plan.ReplicaName.ValueString(), plan.Shard.ValueString(), plan.Collection.ValueString()),
+		)
+		return
+	}
+
+	setReplicaState(&plan, replica)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *replicaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ReplicaResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, state.Timeouts, "delete", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteReplica(ctx, state.Collection.ValueString(), state.Shard.ValueString(), state.ReplicaName.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error deleting replica", "Could not delete replica, unexpected error: ", err)
+		return
+	}
+}
+
+// setReplicaState copies the CLUSTERSTATUS view of a replica into the model.
+func setReplicaState(model *ReplicaResourceModel, replica ReplicaInfo) {
+	model.Node = types.StringValue(replica.NodeName)
+	model.Core = types.StringValue(replica.Core)
+	model.State = types.StringValue(replica.State)
+	model.Leader = types.BoolValue(replica.Leader == "true")
+	if replica.Type != "" {
+		model.Type = types.StringValue(replicaType(replica.Type))
+	}
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ReplicaCreationRequest holds the ADDREPLICA parameters for a single replica.
+type ReplicaCreationRequest struct {
+	Collection  string
+	Shard       string
+	Node        string
+	Type        string
+	InstanceDir string
+	DataDir     string
+}
+
+// AddReplica adds a replica to a shard and returns the name (core_node id) of
+// the replica Solr created.
+func (c *Client) AddReplica(ctx context.Context, request ReplicaCreationRequest) (string, error) {
+	before, err := c.GetCollectionStatus(ctx, request.Collection)
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{}
+	params.Set("collection", request.Collection)
+	params.Set("shard", request.Shard)
+	if request.Node != "" {
+		params.Set("node", request.Node)
+	}
+	if request.Type != "" {
+		params.Set("type", request.Type)
+	}
+	if request.InstanceDir != "" {
+		params.Set("instanceDir", request.InstanceDir)
+	}
+	if request.DataDir != "" {
+		params.Set("dataDir", request.DataDir)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Adding %s replica to shard %s of collection %s", request.Type, request.Shard, request.Collection))
+
+	err = c.collectionsAPIAsync(ctx, "ADDREPLICA", params)
+	if err != nil {
+		return "", err
+	}
+
+	after, err := c.GetCollectionStatus(ctx, request.Collection)
+	if err != nil {
+		return "", err
+	}
+
+	for name, replica := range after.Shards[request.Shard].Replicas {
+		if _, existed := before.Shards[request.Shard].Replicas[name]; existed {
+			continue
+		}
+		if request.Node != "" && replica.NodeName != request.Node {
+			continue
+		}
+		return name, nil
+	}
+
+	return "", fmt.Errorf("replica added to shard %s of collection %s could not be found in the cluster state", request.Shard, request.Collection)
+}
+
+// DeleteReplica removes a replica from a shard.
+func (c *Client) DeleteReplica(ctx context.Context, collection, shard, replica string) error {
+	params := url.Values{}
+	params.Set("collection", collection)
+	params.Set("shard", shard)
+	params.Set("replica", replica)
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting replica %s from shard %s of collection %s", replica, shard, collection))
+
+	return c.collectionsAPIAsync(ctx, "DELETEREPLICA", params)
+}
+
+// GetReplica returns the cluster state of a single replica and whether it exists.
+func (c *Client) GetReplica(ctx context.Context, collection, shard, replica string) (ReplicaInfo, bool, error) {
+	info, err := c.GetCollectionStatus(ctx, collection)
+	if err != nil {
+		return ReplicaInfo{}, false, err
+	}
+
+	r, ok := info.Shards[shard].Replicas[replica]
+	return r, ok, nil
+}
+
+// replicaType normalizes a CLUSTERSTATUS replica type such as "NRT" to the
+// lower case form accepted by ADDREPLICA.
+func replicaType(t string) string {
+	return strings.ToLower(t)
+}
+
+// AddReplicaProperty sets a property on a replica. Properties that Solr
+// treats as unique per shard, such as preferredLeader, are removed from the
+// other replicas of the shard.
+func (c *Client) AddReplicaProperty(ctx context.Context, collection, shard, replica, property, value string) error {
+	params := url.Values{}
+	params.Set("collection", collection)
+	params.Set("shard", shard)
+	params.Set("replica", replica)
+	params.Set("property", property)
+	params.Set("property.value", value)
+
+	tflog.Info(ctx, fmt.Sprintf("Setting %s=%s on replica %s of collection %s", property, value, replica, collection))
+
+	_, err := c.collectionsAPI(ctx, "ADDREPLICAPROP", params)
+	return err
+}
+
+// DeleteReplicaProperty removes a property from a replica.
+func (c *Client) DeleteReplicaProperty(ctx context.Context, collection, shard, replica, property string) error {
+	params := url.Values{}
+	params.Set("collection", collection)
+	params.Set("shard", shard)
+	params.Set("replica", replica)
+	params.Set("property", property)
+
+	tflog.Info(ctx, fmt.Sprintf("Removing %s from replica %s of collection %s", property, replica, collection))
+
+	_, err := c.collectionsAPI(ctx, "DELETEREPLICAPROP", params)
+	return err
+}
+
+// BalanceShardUnique distributes a per-shard unique property evenly across
+// the nodes hosting the collection.
+func (c *Client) BalanceShardUnique(ctx context.Context, collection, property string) error {
+	params := url.Values{}
+	params.Set("collection", collection)
+	params.Set("property", property)
+	params.Set("onlyactivenodes", "true")
+
+	tflog.Info(ctx, fmt.Sprintf("Balancing %s across collection %s", property, collection))
+
+	_, err := c.collectionsAPI(ctx, "BALANCESHARDUNIQUE", params)
+	return err
+}
+
+// RebalanceLeaders asks Solr to make every shard's preferred leader its
+// actual leader.
+func (c *Client) RebalanceLeaders(ctx context.Context, collection string) error {
+	params := url.Values{}
+	params.Set("collection", collection)
+
+	tflog.Info(ctx, fmt.Sprintf("Rebalancing leaders of collection %s", collection))
+
+	_, err := c.collectionsAPI(ctx, "REBALANCELEADERS", params)
+	return err
+}
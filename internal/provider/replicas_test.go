@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testReplicaServer serves a Solr 7 cluster whose shard1 of films has the
+// replicas in before until ADDREPLICA is called, and those in after since.
+// The parameters of the ADDREPLICA call are stored in added.
+func testReplicaServer(t *testing.T, before, after string, added *url.Values) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/solr/admin/info/system" {
+			fmt.Fprint(w, `{"lucene":{"solr-spec-version":"7.7.3"}}`)
+			return
+		}
+
+		switch r.URL.Query().Get("action") {
+		case "ADDREPLICA":
+			*added = r.URL.Query()
+			fmt.Fprint(w, `{}`)
+		case "REQUESTSTATUS":
+			fmt.Fprint(w, `{"status":{"state":"completed"}}`)
+		case "DELETESTATUS":
+			fmt.Fprint(w, `{}`)
+		case "CLUSTERSTATUS":
+			replicas := before
+			if *added != nil {
+				replicas = after
+			}
+			fmt.Fprintf(w, `{"cluster":{"collections":{"films":{"shards":{"shard1":{"state":"active","replicas":{%s}}}}}}}`, replicas)
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+		}
+	}))
+}
+
+func TestAddReplica(t *testing.T) {
+	var added url.Values
+	server := testReplicaServer(t,
+		`"core_node1":{"node_name":"node1:8983_solr","type":"NRT"}`,
+		`"core_node1":{"node_name":"node1:8983_solr","type":"NRT"},"core_node3":{"node_name":"node2:8983_solr","type":"TLOG"}`,
+		&added,
+	)
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{Host: server.URL})
+	require.NoError(t, err)
+
+	name, err := client.AddReplica(context.Background(), ReplicaCreationRequest{
+		Collection:  "films",
+		Shard:       "shard1",
+		Node:        "node2:8983_solr",
+		Type:        "tlog",
+		InstanceDir: "films_tlog",
+		DataDir:     "/var/solr/films_tlog",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "core_node3", name)
+
+	assert.Equal(t, "films", added.Get("collection"))
+	assert.Equal(t, "shard1", added.Get("shard"))
+	assert.Equal(t, "node2:8983_solr", added.Get("node"))
+	assert.Equal(t, "tlog", added.Get("type"))
+	assert.Equal(t, "films_tlog", added.Get("instanceDir"))
+	assert.Equal(t, "/var/solr/films_tlog", added.Get("dataDir"))
+	assert.NotEmpty(t, added.Get("async"))
+}
+
+// TestAddReplicaIgnoresOtherNewReplicas checks that a replica added to
+// another node at the same time is not taken for the requested one.
+func TestAddReplicaIgnoresOtherNewReplicas(t *testing.T) {
+	var added url.Values
+	server := testReplicaServer(t,
+		`"core_node1":{"node_name":"node1:8983_solr"}`,
+		`"core_node1":{"node_name":"node1:8983_solr"},"core_node3":{"node_name":"node3:8983_solr"},"core_node5":{"node_name":"node2:8983_solr"}`,
+		&added,
+	)
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{Host: server.URL})
+	require.NoError(t, err)
+
+	name, err := client.AddReplica(context.Background(), ReplicaCreationRequest{
+		Collection: "films",
+		Shard:      "shard1",
+		Node:       "node2:8983_solr",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "core_node5", name)
+	assert.Empty(t, added.Get("type"))
+}
+
+func TestAddReplicaNotFound(t *testing.T) {
+	var added url.Values
+	server := testReplicaServer(t,
+		`"core_node1":{"node_name":"node1:8983_solr"}`,
+		`"core_node1":{"node_name":"node1:8983_solr"}`,
+		&added,
+	)
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{Host: server.URL})
+	require.NoError(t, err)
+
+	_, err = client.AddReplica(context.Background(), ReplicaCreationRequest{Collection: "films", Shard: "shard1"})
+	assert.ErrorContains(t, err, "could not be found in the cluster state")
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RetryConfig controls how often and how fast failed requests are retried.
+type RetryConfig struct {
+	MaxRetries int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// defaultRetryConfig is used for the fields of a RetryConfig left empty.
+var defaultRetryConfig = RetryConfig{
+	MaxRetries: 3,
+	MinBackoff: 500 * time.Millisecond,
+	MaxBackoff: 10 * time.Second,
+}
+
+// withDefaults fills in the empty fields of r. A negative MaxRetries
+// disables retries.
+func (r RetryConfig) withDefaults() RetryConfig {
+	if r.MaxRetries == 0 {
+		r.MaxRetries = defaultRetryConfig.MaxRetries
+	}
+	if r.MaxRetries < 0 {
+		r.MaxRetries = 0
+	}
+	if r.MinBackoff <= 0 {
+		r.MinBackoff = defaultRetryConfig.MinBackoff
+	}
+	if r.MaxBackoff <= 0 {
+		r.MaxBackoff = defaultRetryConfig.MaxBackoff
+	}
+	if r.MaxBackoff < r.MinBackoff {
+		r.MaxBackoff = r.MinBackoff
+	}
+	return r
+}
+
+// backoff returns the delay before the given retry, starting at 0: the
+// exponential backoff capped at MaxBackoff, with equal jitter.
+func (r RetryConfig) backoff(retry int) time.Duration {
+	delay := r.MaxBackoff
+	if retry < 32 {
+		if d := r.MinBackoff << uint(retry); d > 0 && d < r.MaxBackoff {
+			delay = d
+		}
+	}
+
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1))
+}
+
+// Collections API actions that are not idempotent: repeating one that
+// reached Solr creates a second replica, shard or backup, or fails because
+// the first attempt already succeeded.
+var nonIdempotentActions = map[string]bool{
+	"CREATE":            true,
+	"CREATESHARD":       true,
+	"ADDREPLICA":        true,
+	"SPLITSHARD":        true,
+	"BACKUP":            true,
+	"RESTORE":           true,
+	"REPLACENODE":       true,
+	"MOVEREPLICA":       true,
+	"REINDEXCOLLECTION": true,
+}
+
+type retrySafeKey struct{}
+
+// withRetrySafe marks requests made with ctx as safe to repeat, for
+// non-idempotent requests made safe by other means such as an async id.
+func withRetrySafe(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retrySafeKey{}, true)
+}
+
+// isRetrySafe reports whether req may be sent again after it possibly
+// reached Solr. Requests are safe when their method is idempotent and they
+// are not a non-idempotent Collections API action, or when they carry an
+// async id, which Solr refuses to run twice.
+func isRetrySafe(req *http.Request) bool {
+	if safe, _ := req.Context().Value(retrySafeKey{}).(bool); safe {
+		return true
+	}
+
+	switch req.Method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+	default:
+		return false
+	}
+
+	query := req.URL.Query()
+	if strings.HasSuffix(req.URL.Path, "/admin/collections") && nonIdempotentActions[strings.ToUpper(query.Get("action"))] {
+		return query.Get("async") != ""
+	}
+
+	return true
+}
+
+// isRetryable reports whether a request that failed with err may succeed
+// when retried: network errors, 429, 502, 503 and expired ZooKeeper
+// sessions. Requests that possibly reached Solr are only retried when
+// isRetrySafe allows it.
+func isRetryable(req *http.Request, err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	if !isRetrySafe(req) {
+		return false
+	}
+
+	var solrErr *SolrError
+	if errors.As(err, &solrErr) {
+		switch solrErr.StatusCode {
+		case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable:
+			return true
+		}
+		return strings.Contains(strings.ToLower(solrErr.Msg), "session expired") ||
+			strings.Contains(strings.ToLower(string(solrErr.Body)), "session expired")
+	}
+
+	var urlErr *url.Error
+	return errors.As(err, &urlErr) && !isCertificateError(err)
+}
+
+// isCertificateError reports whether err is a TLS certificate verification
+// failure, which does not go away by retrying.
+func isCertificateError(err error) bool {
+	var verifyErr *tls.CertificateVerificationError
+	var authorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var invalidErr x509.CertificateInvalidError
+	return errors.As(err, &verifyErr) || errors.As(err, &authorityErr) ||
+		errors.As(err, &hostnameErr) || errors.As(err, &invalidErr)
+}
+
+// retryRequests retries requests that failed with a retryable error, with
+// exponential backoff.
+func (c *Client) retryRequests(next requestFunc) requestFunc {
+	return func(req *http.Request) ([]byte, error) {
+		ctx := req.Context()
+		retry := c.retry.withDefaults()
+		// Sending a request consumes its body, so only requests whose body can
+		// be read again are retried.
+		replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+		for attempt := 0; ; attempt++ {
+			attemptReq := req
+			if attempt > 0 && req.GetBody != nil {
+				attemptReq = req.Clone(ctx)
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				attemptReq.Body = body
+			}
+
+			body, err := next(attemptReq)
+			if err == nil || !replayable || ctx.Err() != nil || attempt >= retry.MaxRetries || !isRetryable(req, err) {
+				return body, err
+			}
+
+			delay := retry.backoff(attempt)
+			tflog.Warn(ctx, "SolrCloud request failed, retrying", map[string]interface{}{
+				"attempt": attempt + 1,
+				"delay":   delay.String(),
+				"error":   err.Error(),
+			})
+
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+	}
+}
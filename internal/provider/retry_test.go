@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryBackoff(t *testing.T) {
+	retry := RetryConfig{MinBackoff: time.Second, MaxBackoff: 5 * time.Second}.withDefaults()
+
+	for i, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		delay := retry.backoff(i)
+		assert.GreaterOrEqual(t, delay, want/2)
+		assert.LessOrEqual(t, delay, want)
+	}
+
+	assert.Equal(t, 3, RetryConfig{}.withDefaults().MaxRetries)
+	assert.Equal(t, 0, RetryConfig{MaxRetries: -1}.withDefaults().MaxRetries)
+}
+
+func TestIsRetrySafe(t *testing.T) {
+	for rawURL, safe := range map[string]bool{
+		"http://solr/solr/admin/collections?action=CLUSTERSTATUS":         true,
+		"http://solr/solr/admin/collections?action=DELETE&name=c":         true,
+		"http://solr/solr/admin/collections?action=CREATE&name=c":         false,
+		"http://solr/solr/admin/collections?action=CREATE&name=c&async=1": true,
+		"http://solr/solr/admin/collections?action=addreplica":            false,
+	} {
+		req, err := http.NewRequest("GET", rawURL, nil)
+		require.NoError(t, err)
+		assert.Equal(t, safe, isRetrySafe(req), rawURL)
+	}
+
+	req, err := http.NewRequest("POST", "http://solr/api/collections", nil)
+	require.NoError(t, err)
+	assert.False(t, isRetrySafe(req))
+	assert.True(t, isRetrySafe(req.WithContext(withRetrySafe(context.Background()))))
+}
+
+func TestDoRequestRetries(t *testing.T) {
+	responses := []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK}
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(responses[requests%len(responses)])
+		requests++
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		Host:  server.URL,
+		Retry: RetryConfig{MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+	})
+	require.NoError(t, err)
+
+	_, err = client.collectionsAPI(context.Background(), "CLUSTERSTATUS", nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, requests)
+
+	// CREATE without an async id is not repeated.
+	requests = 0
+	_, err = client.collectionsAPI(context.Background(), "CREATE", nil)
+	assert.Error(t, err)
+	assert.Equal(t, 1, requests)
+}
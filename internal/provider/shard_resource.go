@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource              = &shardResource{}
+	_ resource.ResourceWithConfigure = &shardResource{}
+)
+
+// NewShardResource is a helper function to simplify the provider implementation.
+func NewShardResource() resource.Resource {
+	return &shardResource{}
+}
+
+// shardResource manages a single shard of an implicit-router collection.
+type shardResource struct {
+	client Client
+}
+
+// ShardResourceModel is the model for the solrcloud_shard resource.
+type ShardResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Collection    types.String   `tfsdk:"collection"`
+	Name          types.String   `tfsdk:"name"`
+	CreateNodeSet []types.String `tfsdk:"create_node_set"`
+	NrtReplicas   types.Int64    `tfsdk:"nrt_replicas"`
+	TlogReplicas  types.Int64    `tfsdk:"tlog_replicas"`
+	PullReplicas  types.Int64    `tfsdk:"pull_replicas"`
+	State         types.String   `tfsdk:"state"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *shardResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = *client
+}
+
+// Schema defines the schema for the resource.
+func (r *shardResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a shard of a collection that uses the implicit router.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The shard identifier in the form collection/shard.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collection": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the implicit-router collection the shard belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the shard.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"create_node_set": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The nodes to place the shard's replicas on.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"nrt_replicas": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The number of NRT replicas to create. Defaults to the collection's setting.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"tlog_replicas": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The number of TLOG replicas to create. Defaults to the collection's setting.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"pull_replicas": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The number of PULL replicas to create. Defaults to the collection's setting.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Computed:    true,
+				Description: "The state of the shard as reported by CLUSTERSTATUS.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Delete: true}),
+		},
+	}
+}
+
+func (r *shardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_shard"
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *shardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ShardResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts, "create", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collection, err := r.client.GetCollectionStatus(ctx, plan.Collection.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading collection", "Could not read collection "+plan.Collection.ValueString()+": ", err)
+		return
+	}
+
+	if collection.Router.Name != "implicit" {
+		resp.Diagnostics.AddError(
+			"Unsupported collection router",
+			fmt.Sprintf("Shards can only be created in collections using the implicit router, but %s uses %q. "+
+				"Use the split block of solrcloud_collection to add shards to compositeId collections.", plan.Collection.ValueString(), collection.Router.Name),
+		)
+		return
+	}
+
+	var nodeSet []string
+	for _, node := range plan.CreateNodeSet {
+		nodeSet = append(nodeSet, node.ValueString())
+	}
+
+	err = r.client.CreateShard(ctx, ShardCreationRequest{
+		Collection:    plan.Collection.ValueString(),
+		Shard:         plan.Name.ValueString(),
+		CreateNodeSet: nodeSet,
+		NrtReplicas:   int(plan.NrtReplicas.ValueInt64()),
+		TlogReplicas:  int(plan.TlogReplicas.ValueInt64()),
+		PullReplicas:  int(plan.PullReplicas.ValueInt64()),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating shard", "Could not create shard, unexpected error: ", err)
+		return
+	}
+
+	collection, err = r.client.GetCollectionStatus(ctx, plan.Collection.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading collection", "Could not read collection "+plan.Collection.ValueString()+": ", err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.Collection.ValueString() + "/" + plan.Name.ValueString())
+	plan.State = types.StringValue(collection.Shards[plan.Name.ValueString()].State)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *shardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ShardResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, state.Timeouts, "read", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collection, err := r.client.GetCollectionStatus(ctx, state.Collection.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading shard", "Could not read shard, unexpected error: ", err)
+		return
+	}
+
+	shard, ok := collection.Shards[state.Name.ValueString()]
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.State = types.StringValue(shard.State)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only stores changes to the timeouts block, because every other
+// configurable attribute requires replacement.
+func (r *shardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ShardResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *shardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ShardResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, state.Timeouts, "delete", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteShard(ctx, state.Collection.ValueString(), state.Name.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error deleting shard", "Could not delete shard, unexpected error: ", err)
+		return
+	}
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ShardCreationRequest holds the CREATESHARD parameters for an implicit-router
+// collection.
+type ShardCreationRequest struct {
+	Collection    string
+	Shard         string
+	CreateNodeSet []string
+	NrtReplicas   int
+	TlogReplicas  int
+	PullReplicas  int
+}
+
+// CreateShard adds a new shard to a collection using the implicit router.
+func (c *Client) CreateShard(ctx context.Context, request ShardCreationRequest) error {
+	params := url.Values{}
+	params.Set("collection", request.Collection)
+	params.Set("shard", request.Shard)
+	if len(request.CreateNodeSet) > 0 {
+		params.Set("createNodeSet", strings.Join(request.CreateNodeSet, ","))
+	}
+	if request.NrtReplicas > 0 {
+		params.Set("nrtReplicas", strconv.Itoa(request.NrtReplicas))
+	}
+	if request.TlogReplicas > 0 {
+		params.Set("tlogReplicas", strconv.Itoa(request.TlogReplicas))
+	}
+	if request.PullReplicas > 0 {
+		params.Set("pullReplicas", strconv.Itoa(request.PullReplicas))
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Creating shard %s in collection %s", request.Shard, request.Collection))
+
+	return c.collectionsAPIAsync(ctx, "CREATESHARD", params)
+}
+
+// DeleteShard removes a shard and all of its replicas from a collection. Solr
+// only allows this for inactive shards or shards of implicit-router collections.
+func (c *Client) DeleteShard(ctx context.Context, collection, shard string) error {
+	params := url.Values{}
+	params.Set("collection", collection)
+	params.Set("shard", shard)
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting shard %s from collection %s", shard, collection))
+
+	return c.collectionsAPIAsync(ctx, "DELETESHARD", params)
+}
+
+// ShardSplitRequest holds the SPLITSHARD parameters. Exactly one of Shard or
+// SplitKey identifies the shard to split.
+type ShardSplitRequest struct {
+	Collection   string
+	Shard        string
+	SplitKey     string
+	Ranges       string
+	NumSubShards int
+	SplitMethod  string
+}
+
+// SplitShard splits a shard of a compositeId collection into sub-shards and
+// waits for the split to finish. The parent shard is left inactive.
+func (c *Client) SplitShard(ctx context.Context, request ShardSplitRequest) error {
+	params := url.Values{}
+	params.Set("collection", request.Collection)
+	if request.Shard != "" {
+		params.Set("shard", request.Shard)
+	}
+	if request.SplitKey != "" {
+		params.Set("split.key", request.SplitKey)
+	}
+	if request.Ranges != "" {
+		params.Set("ranges", request.Ranges)
+	}
+	if request.NumSubShards > 0 {
+		params.Set("numSubShards", strconv.Itoa(request.NumSubShards))
+	}
+	if request.SplitMethod != "" {
+		params.Set("splitMethod", request.SplitMethod)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Splitting shard %s%s of collection %s", request.Shard, request.SplitKey, request.Collection))
+
+	return c.collectionsAPIAsync(ctx, "SPLITSHARD", params)
+}
+
+// activeShards returns the names of the collection's active shards in name order.
+func activeShards(collection CollectionInfo) []string {
+	var names []string
+	for name, shard := range collection.Shards {
+		if shard.State == "active" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// shardRangeWidth returns the size of a compositeId hash range such as
+// "80000000-ffffffff", or 0 if the range cannot be parsed.
+func shardRangeWidth(hashRange string) uint64 {
+	bounds := strings.SplitN(hashRange, "-", 2)
+	if len(bounds) != 2 {
+		return 0
+	}
+	lower, err := strconv.ParseUint(bounds[0], 16, 32)
+	if err != nil {
+		return 0
+	}
+	upper, err := strconv.ParseUint(bounds[1], 16, 32)
+	if err != nil || upper < lower {
+		return 0
+	}
+	return upper - lower + 1
+}
+
+// widestActiveShard returns the active shard covering the largest hash range,
+// which is the best candidate for an automatic split.
+func widestActiveShard(collection CollectionInfo) string {
+	var widest string
+	var widestRange uint64
+	for _, name := range activeShards(collection) {
+		width := shardRangeWidth(collection.Shards[name].Range)
+		if widest == "" || width > widestRange {
+			widest, widestRange = name, width
+		}
+	}
+	return widest
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShardRangeWidth(t *testing.T) {
+	tests := []struct {
+		hashRange string
+		want      uint64
+	}{
+		{"80000000-ffffffff", 0x80000000},
+		{"0-7fffffff", 0x80000000},
+		{"0-ffffffff", 0x100000000},
+		{"10-10", 1},
+		{"", 0},
+		{"80000000", 0},
+		{"ffff-0", 0},
+		{"xyz-ffff", 0},
+		{"0-100000000", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.hashRange, func(t *testing.T) {
+			assert.Equal(t, tt.want, shardRangeWidth(tt.hashRange))
+		})
+	}
+}
+
+func TestActiveShards(t *testing.T) {
+	tests := []struct {
+		name   string
+		shards map[string]ShardInfo
+		want   []string
+	}{
+		{"none", nil, nil},
+		{
+			"sorted",
+			map[string]ShardInfo{"shard2": {State: "active"}, "shard1": {State: "active"}},
+			[]string{"shard1", "shard2"},
+		},
+		{
+			"split parent",
+			map[string]ShardInfo{
+				"shard1":   {State: "inactive"},
+				"shard1_0": {State: "active"},
+				"shard1_1": {State: "active"},
+				"shard2":   {State: "active"},
+			},
+			[]string{"shard1_0", "shard1_1", "shard2"},
+		},
+		{
+			"construction",
+			map[string]ShardInfo{"shard1": {State: "active"}, "shard1_0": {State: "construction"}},
+			[]string{"shard1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, activeShards(CollectionInfo{Shards: tt.shards}))
+		})
+	}
+}
+
+func TestWidestActiveShard(t *testing.T) {
+	tests := []struct {
+		name   string
+		shards map[string]ShardInfo
+		want   string
+	}{
+		{"none", nil, ""},
+		{
+			"widest",
+			map[string]ShardInfo{
+				"shard1_0": {State: "active", Range: "80000000-bfffffff"},
+				"shard1_1": {State: "active", Range: "c0000000-ffffffff"},
+				"shard2":   {State: "active", Range: "0-7fffffff"},
+			},
+			"shard2",
+		},
+		{
+			"inactive parent ignored",
+			map[string]ShardInfo{
+				"shard1":   {State: "inactive", Range: "0-ffffffff"},
+				"shard1_0": {State: "active", Range: "0-7fffffff"},
+				"shard1_1": {State: "active", Range: "80000000-bfffffff"},
+			},
+			"shard1_0",
+		},
+		{
+			"tie picks first by name",
+			map[string]ShardInfo{
+				"shard2": {State: "active", Range: "80000000-ffffffff"},
+				"shard1": {State: "active", Range: "0-7fffffff"},
+			},
+			"shard1",
+		},
+		{
+			"unparsable ranges",
+			map[string]ShardInfo{"shard1": {State: "active"}, "shard2": {State: "active"}},
+			"shard1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, widestActiveShard(CollectionInfo{Shards: tt.shards}))
+		})
+	}
+}
+
+func TestSplitPlanError(t *testing.T) {
+	tests := []struct {
+		name    string
+		split   CollectionSplitModel
+		current int
+		planned int
+		wantErr bool
+	}{
+		{"automatic halves", CollectionSplitModel{}, 2, 4, false},
+		{"automatic thirds", CollectionSplitModel{NumSubShards: types.Int64Value(3)}, 2, 6, false},
+		{"automatic thirds overshoot", CollectionSplitModel{NumSubShards: types.Int64Value(3)}, 2, 5, true},
+		{"targeted shard", CollectionSplitModel{Shard: types.StringValue("shard1")}, 2, 3, false},
+		{"targeted shard too few", CollectionSplitModel{Shard: types.StringValue("shard1")}, 2, 4, true},
+		{"targeted sub-shards", CollectionSplitModel{SplitKey: types.StringValue("a!"), NumSubShards: types.Int64Value(4)}, 1, 4, false},
+		{"targeted ranges", CollectionSplitModel{Shard: types.StringValue("shard1"), Ranges: types.StringValue("0-1f4,1f5-3e8,3e9-5dc")}, 1, 3, false},
+		{"targeted ranges mismatch", CollectionSplitModel{Shard: types.StringValue("shard1"), Ranges: types.StringValue("0-1f4,1f5-3e8")}, 1, 3, true},
+		{"single sub-shard", CollectionSplitModel{NumSubShards: types.Int64Value(1)}, 1, 2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := splitPlanError(&tt.split, tt.current, tt.planned)
+			if tt.wantErr {
+				assert.NotEmpty(t, msg)
+			} else {
+				assert.Empty(t, msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// SystemInfoResponse is the /admin/info/system response of a Solr node.
+type SystemInfoResponse struct {
+	ResponseHeader ResponseHeader `json:"responseHeader"`
+	Mode           string         `json:"mode"`
+	ZkHost         string         `json:"zkHost"`
+	SolrHome       string         `json:"solr_home"`
+	Node           string         `json:"node"`
+	Lucene         struct {
+		SolrSpecVersion   string `json:"solr-spec-version"`
+		SolrImplVersion   string `json:"solr-impl-version"`
+		LuceneSpecVersion string `json:"lucene-spec-version"`
+		LuceneImplVersion string `json:"lucene-impl-version"`
+	} `json:"lucene"`
+	JVM struct {
+		Version    string `json:"version"`
+		Name       string `json:"name"`
+		Processors int    `json:"processors"`
+		Memory     struct {
+			Raw struct {
+				Free  int64 `json:"free"`
+				Total int64 `json:"total"`
+				Max   int64 `json:"max"`
+				Used  int64 `json:"used"`
+			} `json:"raw"`
+		} `json:"memory"`
+	} `json:"jvm"`
+}
+
+// GetSystemInfo returns the version, JVM and mode information of a single
+// node, the one nodeURL picks.
+func (c *Client) GetSystemInfo(ctx context.Context) (SystemInfoResponse, error) {
+	var response SystemInfoResponse
+
+	err := c.getJSON(withPinnedNode(ctx), fmt.Sprintf("%s/solr/admin/info/system?wt=json", c.nodeURL(ctx)), &response)
+	return response, err
+}
+
+// SolrVersion is a parsed Solr release version.
+type SolrVersion struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// ParseSolrVersion parses versions such as "9.4.0" or "8.11.2-SNAPSHOT".
+func ParseSolrVersion(version string) (SolrVersion, error) {
+	var v SolrVersion
+
+	version, _, _ = strings.Cut(strings.TrimSpace(version), "-")
+	parts := strings.Split(version, ".")
+	if len(parts) < 2 {
+		return v, fmt.Errorf("invalid Solr version %q", version)
+	}
+
+	numbers := []*int{&v.Major, &v.Minor, &v.Patch}
+	for i, part := range parts {
+		if i >= len(numbers) {
+			break
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return v, fmt.Errorf("invalid Solr version %q: %w", version, err)
+		}
+		*numbers[i] = n
+	}
+
+	return v, nil
+}
+
+// AtLeast reports whether v is the same as or newer than other.
+func (v SolrVersion) AtLeast(other SolrVersion) bool {
+	if v.Major != other.Major {
+		return v.Major > other.Major
+	}
+	if v.Minor != other.Minor {
+		return v.Minor > other.Minor
+	}
+	return v.Patch >= other.Patch
+}
+
+func (v SolrVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Minimum Solr versions of features the provider gates on.
+var (
+	solrVersionIncrementalBackup = SolrVersion{Major: 8, Minor: 9}
+	solrVersionNodeRoles         = SolrVersion{Major: 9, Minor: 1}
+)
+
+// Solr versions from which the v2 API is used instead of the v1 Collections
+// API for an operation. Older versions either lack the v2 endpoint or expect
+// a different request body.
+var (
+	solrVersionV2CollectionStatus = SolrVersion{Major: 8}
+	solrVersionV2CreateCollection = SolrVersion{Major: 9, Minor: 3}
+)
+
+// UnsupportedVersionError is returned when a feature needs a newer Solr
+// version than the cluster runs.
+type UnsupportedVersionError struct {
+	Feature  string
+	Required SolrVersion
+	Actual   SolrVersion
+}
+
+func (e *UnsupportedVersionError) Error() string {
+	return fmt.Sprintf("%s requires Solr %s or newer, but the cluster runs Solr %s", e.Feature, e.Required, e.Actual)
+}
+
+// solrVersionCache holds the Solr version detected for a provider instance.
+// It is shared by every copy of the Client made from the same NewClient call.
+type solrVersionCache struct {
+	mu      sync.Mutex
+	version *SolrVersion
+}
+
+// get returns the cached version, if any.
+func (v *solrVersionCache) get() (SolrVersion, bool) {
+	if v == nil {
+		return SolrVersion{}, false
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.version == nil {
+		return SolrVersion{}, false
+	}
+	return *v.version, true
+}
+
+// set caches version.
+func (v *solrVersionCache) set(version SolrVersion) {
+	if v == nil {
+		return
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.version = &version
+}
+
+// SolrVersion returns the version of the Solr cluster. It is detected with
+// /admin/info/system of a single node on first use and cached; failed
+// detections are retried on the next call. Concurrent first calls may each
+// ask Solr, but the lock is never held while waiting for it.
+func (c *Client) SolrVersion(ctx context.Context) (SolrVersion, error) {
+	if version, ok := c.version.get(); ok {
+		return version, nil
+	}
+
+	info, err := c.GetSystemInfo(ctx)
+	if err != nil {
+		return SolrVersion{}, fmt.Errorf("unable to determine the Solr version: %w", err)
+	}
+
+	version, err := ParseSolrVersion(info.Lucene.SolrSpecVersion)
+	if err != nil {
+		return SolrVersion{}, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Detected Solr version %s", version))
+
+	c.version.set(version)
+	return version, nil
+}
+
+// requireSolrVersion returns an UnsupportedVersionError naming the feature
+// when the cluster runs a Solr version older than minimum.
+func (c *Client) requireSolrVersion(ctx context.Context, minimum SolrVersion, feature string) error {
+	version, err := c.SolrVersion(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !version.AtLeast(minimum) {
+		return &UnsupportedVersionError{Feature: feature, Required: minimum, Actual: version}
+	}
+
+	return nil
+}
+
+// useV2API reports whether an operation whose v2 endpoint is usable from
+// v2Since should go through the v2 API. The v1 Collections API is supported
+// by every SolrCloud release, so it is used whenever the version is unknown.
+func (c *Client) useV2API(ctx context.Context, v2Since SolrVersion) bool {
+	version, err := c.SolrVersion(ctx)
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Falling back to the v1 API: %s", err))
+		return false
+	}
+	return version.AtLeast(v2Since)
+}
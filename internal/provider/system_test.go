@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSolrVersion(t *testing.T) {
+	for input, expected := range map[string]SolrVersion{
+		"9.4.0":           {Major: 9, Minor: 4},
+		"8.11.2":          {Major: 8, Minor: 11, Patch: 2},
+		"9.0.0-SNAPSHOT":  {Major: 9},
+		"7.7":             {Major: 7, Minor: 7},
+		" 9.1.1.20230101": {Major: 9, Minor: 1, Patch: 1},
+	} {
+		version, err := ParseSolrVersion(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, version, input)
+	}
+
+	_, err := ParseSolrVersion("nine")
+	assert.Error(t, err)
+}
+
+func TestSolrVersionAtLeast(t *testing.T) {
+	assert.True(t, SolrVersion{Major: 9, Minor: 1}.AtLeast(solrVersionNodeRoles))
+	assert.True(t, SolrVersion{Major: 10}.AtLeast(solrVersionNodeRoles))
+	assert.False(t, SolrVersion{Major: 9, Minor: 0, Patch: 9}.AtLeast(solrVersionNodeRoles))
+	assert.False(t, SolrVersion{Major: 8, Minor: 11}.AtLeast(solrVersionNodeRoles))
+}
+
+func TestClientSolrVersionIsCached(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `{"mode":"solrcloud","lucene":{"solr-spec-version":"8.11.2"}}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{Host: server.URL})
+	assert.NoError(t, err)
+
+	// Resources and data sources hold copies of the provider's client.
+	copied := *client
+
+	version, err := client.SolrVersion(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, SolrVersion{Major: 8, Minor: 11, Patch: 2}, version)
+
+	err = copied.requireSolrVersion(context.Background(), solrVersionNodeRoles, "the node roles API")
+	var versionErr *UnsupportedVersionError
+	assert.ErrorAs(t, err, &versionErr)
+	assert.False(t, copied.useV2API(context.Background(), solrVersionV2CreateCollection))
+	assert.Equal(t, 1, calls)
+}
+
+func TestSolrVersionUnlockedDuringRequest(t *testing.T) {
+	var client *Client
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Other callers must not be blocked while Solr is being asked.
+		locked := client.version.mu.TryLock()
+		if locked {
+			client.version.mu.Unlock()
+		}
+		assert.True(t, locked)
+		fmt.Fprint(w, `{"mode":"solrcloud","lucene":{"solr-spec-version":"9.4.0"}}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{Host: server.URL})
+	assert.NoError(t, err)
+
+	version, err := client.SolrVersion(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, SolrVersion{Major: 9, Minor: 4}, version)
+}
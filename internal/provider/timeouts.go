@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// defaultTimeouts are the timeouts of resource operations that are not set
+// in a timeouts block.
+var defaultTimeouts = map[string]time.Duration{
+	"create": 20 * time.Minute,
+	"read":   5 * time.Minute,
+	"update": 20 * time.Minute,
+	"delete": 20 * time.Minute,
+}
+
+// withTimeout returns a context that is cancelled after the timeout
+// configured for operation in the timeouts block, or its default when the
+// timeout is unset or not positive.
+func withTimeout(ctx context.Context, value timeouts.Value, operation string, diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	configured := map[string]func(context.Context, time.Duration) (time.Duration, diag.Diagnostics){
+		"create": value.Create,
+		"read":   value.Read,
+		"update": value.Update,
+		"delete": value.Delete,
+	}[operation]
+
+	timeout, timeoutDiags := configured(ctx, defaultTimeouts[operation])
+	diags.Append(timeoutDiags...)
+	if timeout <= 0 {
+		timeout = defaultTimeouts[operation]
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
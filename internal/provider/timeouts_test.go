@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// testTimeouts returns a timeouts block value with the given durations set.
+func testTimeouts(durations map[string]string) timeouts.Value {
+	attributeTypes := map[string]attr.Type{}
+	attributes := map[string]attr.Value{}
+	for operation := range defaultTimeouts {
+		attributeTypes[operation] = types.StringType
+		attributes[operation] = types.StringNull()
+		if duration, ok := durations[operation]; ok {
+			attributes[operation] = types.StringValue(duration)
+		}
+	}
+	return timeouts.Value{Object: types.ObjectValueMust(attributeTypes, attributes)}
+}
+
+func TestWithTimeout(t *testing.T) {
+	var diags diag.Diagnostics
+
+	ctx, cancel := withTimeout(context.Background(), timeouts.Value{Object: types.ObjectNull(nil)}, "create", &diags)
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	assert.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(defaultTimeouts["create"]), deadline, time.Second)
+
+	ctx, cancel = withTimeout(context.Background(), testTimeouts(map[string]string{"delete": "90s"}), "delete", &diags)
+	defer cancel()
+	deadline, _ = ctx.Deadline()
+	assert.WithinDuration(t, time.Now().Add(90*time.Second), deadline, time.Second)
+	assert.False(t, diags.HasError())
+
+	_, cancel = withTimeout(context.Background(), testTimeouts(map[string]string{"read": "soon"}), "read", &diags)
+	defer cancel()
+	assert.True(t, diags.HasError())
+}
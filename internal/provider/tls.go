@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// TLSConfig holds the TLS settings of the connection to Solr. Certificates
+// and keys are given either as PEM text or as a path to a PEM file.
+type TLSConfig struct {
+	CACert             string
+	ClientCert         string
+	ClientKey          string
+	InsecureSkipVerify bool
+	ServerName         string
+}
+
+// newTransport returns the transport every request of a Client goes through,
+// configured with the given TLS settings.
+func newTransport(config TLSConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	tlsConfig, err := config.build()
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
+
+// build returns the crypto/tls configuration for the settings.
+func (c TLSConfig) build() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+		ServerName:         c.ServerName,
+	}
+
+	if c.CACert != "" {
+		caCert, err := readPEM(c.CACert)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read ca_cert: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("ca_cert does not contain any PEM encoded certificates")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if (c.ClientCert == "") != (c.ClientKey == "") {
+		return nil, errors.New("client_cert and client_key must be set together")
+	}
+
+	if c.ClientCert != "" {
+		clientCert, err := readPEM(c.ClientCert)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client_cert: %w", err)
+		}
+
+		clientKey, err := readPEM(c.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client_key: %w", err)
+		}
+
+		certificate, err := tls.X509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
+
+// readPEM returns value itself when it holds PEM text and otherwise reads the
+// file it points to.
+func readPEM(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+	return os.ReadFile(value)
+}
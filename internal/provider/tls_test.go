@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientTLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"lucene":{"solr-spec-version":"9.4.0"}}`)
+	}))
+	defer server.Close()
+
+	caCert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	client, err := NewClient(ClientConfig{Host: server.URL, TLS: TLSConfig{CACert: caCert}})
+	assert.NoError(t, err)
+	_, err = client.GetSystemInfo(context.Background())
+	assert.NoError(t, err)
+
+	client, err = NewClient(ClientConfig{Host: server.URL})
+	assert.NoError(t, err)
+	_, err = client.GetSystemInfo(context.Background())
+	assert.Error(t, err)
+
+	client, err = NewClient(ClientConfig{Host: server.URL, TLS: TLSConfig{InsecureSkipVerify: true}})
+	assert.NoError(t, err)
+	_, err = client.GetSystemInfo(context.Background())
+	assert.NoError(t, err)
+
+	_, err = NewClient(ClientConfig{Host: server.URL, TLS: TLSConfig{ClientCert: caCert}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenExpiryLeeway is how long before its expiry a cached token is renewed.
+const tokenExpiryLeeway = 30 * time.Second
+
+// tokenSource supplies the bearer token sent with every request.
+type tokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// OAuth2Config holds the settings of the OAuth2 client credentials flow.
+type OAuth2Config struct {
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+}
+
+// staticTokenSource always returns the same token.
+type staticTokenSource string
+
+func (s staticTokenSource) Token(context.Context) (string, error) {
+	return string(s), nil
+}
+
+// fileTokenSource reads the token from a file, such as one kept up to date
+// by a sidecar, and reads it again once the cached token has expired.
+type fileTokenSource struct {
+	path string
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+func (s *fileTokenSource) Token(context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && !s.expiry.IsZero() && time.Now().Add(tokenExpiryLeeway).Before(s.expiry) {
+		return s.token, nil
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read token file: %w", err)
+	}
+
+	s.token = strings.TrimSpace(string(data))
+	if s.token == "" {
+		return "", fmt.Errorf("token file %s is empty", s.path)
+	}
+	// Tokens without an exp claim are read from the file on every request.
+	s.expiry = jwtExpiry(s.token)
+
+	return s.token, nil
+}
+
+// oauth2TokenSource obtains tokens with the OAuth2 client credentials grant
+// and caches them until shortly before they expire.
+type oauth2TokenSource struct {
+	config     OAuth2Config
+	httpClient *http.Client
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// oauth2TokenResponse is the token endpoint response of RFC 6749 section 5.1.
+type oauth2TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+func (s *oauth2TokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && time.Now().Add(tokenExpiryLeeway).Before(s.expiry) {
+		return s.token, nil
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	if len(s.config.Scopes) > 0 {
+		form.Set("scope", strings.Join(s.config.Scopes, " "))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", s.config.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("error creating token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(s.config.ClientID), url.QueryEscape(s.config.ClientSecret))
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error requesting token: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("error reading token response: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token endpoint returned status: %d, body: %s", res.StatusCode, body)
+	}
+
+	var token oauth2TokenResponse
+	if err := json.Unmarshal(body, &token); err != nil {
+		return "", fmt.Errorf("error unmarshalling token response: %w", err)
+	}
+	if token.AccessToken == "" {
+		return "", errors.New("token endpoint did not return an access_token")
+	}
+
+	s.token = token.AccessToken
+	s.expiry = jwtExpiry(token.AccessToken)
+	if token.ExpiresIn > 0 {
+		s.expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+
+	return s.token, nil
+}
+
+// jwtExpiry returns the exp claim of a JWT, or the zero time if the token is
+// not a JWT or has no exp claim. The signature is not verified; Solr does that.
+func jwtExpiry(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}
+	}
+
+	var claims struct {
+		Exp float64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(int64(claims.Exp), 0)
+}
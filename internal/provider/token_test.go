@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testJWT returns an unsigned JWT that expires at exp.
+func testJWT(exp time.Time) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	payload := fmt.Sprintf(`{"sub":"terraform","exp":%d}`, exp.Unix())
+	return encode([]byte(`{"alg":"none"}`)) + "." + encode([]byte(payload)) + ".sig"
+}
+
+func TestJWTExpiry(t *testing.T) {
+	exp := time.Unix(1900000000, 0)
+	assert.Equal(t, exp, jwtExpiry(testJWT(exp)))
+	assert.True(t, jwtExpiry("opaque-token").IsZero())
+}
+
+func TestFileTokenSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	source := &fileTokenSource{path: path}
+
+	valid := testJWT(time.Now().Add(time.Hour))
+	require.NoError(t, os.WriteFile(path, []byte(valid+"\n"), 0o600))
+
+	token, err := source.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, valid, token)
+
+	// A valid token is served from the cache.
+	require.NoError(t, os.WriteFile(path, []byte("rotated"), 0o600))
+	token, err = source.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, valid, token)
+
+	// An expired token is read again from the file.
+	source.expiry = time.Now().Add(-time.Minute)
+	token, err = source.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "rotated", token)
+}
+
+func TestOAuth2TokenSource(t *testing.T) {
+	requests := 0
+	idp := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		id, secret, _ := r.BasicAuth()
+		assert.Equal(t, "terraform", id)
+		assert.Equal(t, "s3cret", secret)
+		assert.Equal(t, "client_credentials", r.FormValue("grant_type"))
+		assert.Equal(t, "solr:admin", r.FormValue("scope"))
+		fmt.Fprint(w, `{"access_token":"access","token_type":"Bearer","expires_in":3600}`)
+	}))
+	defer idp.Close()
+
+	var authorization string
+	solr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+	}))
+	defer solr.Close()
+
+	client, err := NewClient(ClientConfig{
+		Host: solr.URL,
+		OAuth2: &OAuth2Config{
+			TokenURL:     idp.URL,
+			ClientID:     "terraform",
+			ClientSecret: "s3cret",
+			Scopes:       []string{"solr:admin"},
+		},
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		req, err := http.NewRequest("GET", solr.URL, nil)
+		require.NoError(t, err)
+		_, err = client.doRequest(req)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, "Bearer access", authorization)
+	assert.Equal(t, 1, requests)
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/go-zookeeper/zk"
+)
+
+// zkSessionTimeout is the session timeout requested from ZooKeeper, and how
+// long a lookup waits for the ensemble to answer.
+var zkSessionTimeout = 10 * time.Second
+
+// liveNodesSource lists the base URLs, such as http://10.0.0.1:8983, of the
+// live Solr nodes of a cluster.
+type liveNodesSource interface {
+	LiveNodeURLs(ctx context.Context) ([]string, error)
+}
+
+// zkReader is the part of a ZooKeeper connection used to read znodes. It is
+// implemented by *zk.Conn.
+type zkReader interface {
+	Children(path string) ([]string, *zk.Stat, error)
+	Get(path string) ([]byte, *zk.Stat, error)
+	Close()
+}
+
+// zkLiveNodes discovers live Solr nodes from /live_nodes in ZooKeeper, the
+// way SolrJ's CloudSolrClient does. Each lookup opens its own session and
+// closes it when done, since lookups are at most liveNodesRefreshInterval
+// apart and nothing closes the provider's client.
+//
+// Ensembles that require SASL authentication or TLS are not supported: the
+// session is unauthenticated and in plain text. Lookups against them fail
+// with an error naming the likely cause.
+type zkLiveNodes struct {
+	servers []string
+	chroot  string
+	connect func(servers []string) (zkReader, error)
+}
+
+// newZKLiveNodes returns a liveNodesSource for the given ZooKeeper servers,
+// each given as host:port, and chroot, such as /solr.
+func newZKLiveNodes(servers []string, chroot string) (*zkLiveNodes, error) {
+	if chroot != "" && (!strings.HasPrefix(chroot, "/") || strings.HasSuffix(chroot, "/")) {
+		return nil, fmt.Errorf("invalid ZooKeeper chroot %q, expected a path such as /solr", chroot)
+	}
+
+	for _, server := range servers {
+		if _, _, err := net.SplitHostPort(server); err != nil {
+			return nil, fmt.Errorf("invalid ZooKeeper host %q, expected host:port", server)
+		}
+	}
+
+	return &zkLiveNodes{servers: servers, chroot: chroot, connect: connectZK}, nil
+}
+
+// connectZK opens a ZooKeeper session. The client connects in the
+// background and reconnects on its own; its log output is dropped because
+// the provider's output belongs to Terraform, and connection problems
+// surface as lookup errors instead.
+func connectZK(servers []string) (zkReader, error) {
+	conn, _, err := zk.Connect(servers, zkSessionTimeout, zk.WithLogger(log.New(io.Discard, "", 0)), zk.WithLogInfo(false))
+	if err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+// LiveNodeURLs reads the live nodes in a new session. A session that does
+// not answer in time is closed without waiting for it.
+func (z *zkLiveNodes) LiveNodeURLs(ctx context.Context) ([]string, error) {
+	conn, err := z.connect(z.servers)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to ZooKeeper: %w", err)
+	}
+	defer conn.Close()
+
+	type result struct {
+		urls []string
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		urls, err := z.liveNodeURLs(conn)
+		done <- result{urls, err}
+	}()
+
+	timer := time.NewTimer(zkSessionTimeout)
+	defer timer.Stop()
+
+	select {
+	case r := <-done:
+		if errors.Is(r.err, zk.ErrNoAuth) {
+			return nil, fmt.Errorf("unable to read live nodes from ZooKeeper: %w; ZooKeeper ACLs that require authentication are not supported, set hosts instead of zk_hosts", r.err)
+		}
+		if r.err != nil {
+			return nil, fmt.Errorf("unable to read live nodes from ZooKeeper: %w", r.err)
+		}
+		return r.urls, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("unable to read live nodes from ZooKeeper: %w", ctx.Err())
+	case <-timer.C:
+		return nil, fmt.Errorf("unable to read live nodes from ZooKeeper: no answer from %s within %s; ensembles that require SASL authentication or TLS are not supported, set hosts instead of zk_hosts", strings.Join(z.servers, ","), zkSessionTimeout)
+	}
+}
+
+func (z *zkLiveNodes) liveNodeURLs(conn zkReader) ([]string, error) {
+	nodes, _, err := conn.Children(z.chroot + "/live_nodes")
+	if err != nil {
+		return nil, err
+	}
+
+	// The URL scheme of the nodes is a cluster property, http by default.
+	scheme := "http"
+	data, _, err := conn.Get(z.chroot + "/clusterprops.json")
+	if err != nil && !errors.Is(err, zk.ErrNoNode) {
+		return nil, err
+	}
+	if len(data) > 0 {
+		var props struct {
+			URLScheme string `json:"urlScheme"`
+		}
+		if err := json.Unmarshal(data, &props); err == nil && props.URLScheme != "" {
+			scheme = props.URLScheme
+		}
+	}
+
+	urls := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		hostPort, _, _ := strings.Cut(node, "_")
+		urls = append(urls, scheme+"://"+hostPort)
+	}
+
+	return urls, nil
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-zookeeper/zk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeZooKeeper is a zkReader that serves fixed znodes. A non-nil err makes
+// reads fail with it, and a non-nil block makes them wait until it is closed.
+type fakeZooKeeper struct {
+	children map[string][]string
+	data     map[string][]byte
+	err      error
+	block    chan struct{}
+	sessions int
+	closed   int
+}
+
+func newFakeZooKeeper() *fakeZooKeeper {
+	return &fakeZooKeeper{children: map[string][]string{}, data: map[string][]byte{}}
+}
+
+// connect is used as the connect function of zkLiveNodes.
+func (f *fakeZooKeeper) connect(servers []string) (zkReader, error) {
+	f.sessions++
+	return f, nil
+}
+
+func (f *fakeZooKeeper) Children(path string) ([]string, *zk.Stat, error) {
+	if f.block != nil {
+		<-f.block
+	}
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+	children, ok := f.children[path]
+	if !ok {
+		return nil, nil, zk.ErrNoNode
+	}
+	return children, &zk.Stat{}, nil
+}
+
+func (f *fakeZooKeeper) Get(path string) ([]byte, *zk.Stat, error) {
+	data, ok := f.data[path]
+	if !ok {
+		return nil, nil, zk.ErrNoNode
+	}
+	return data, &zk.Stat{}, nil
+}
+
+func (f *fakeZooKeeper) Close() {
+	f.closed++
+}
+
+func TestZKLiveNodes(t *testing.T) {
+	fake := newFakeZooKeeper()
+	fake.children["/solr/live_nodes"] = []string{"10.0.0.1:8983_solr", "10.0.0.2:8983_solr"}
+
+	source, err := newZKLiveNodes([]string{"127.0.0.1:2181"}, "/solr")
+	require.NoError(t, err)
+	source.connect = fake.connect
+
+	urls, err := source.LiveNodeURLs(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"http://10.0.0.1:8983", "http://10.0.0.2:8983"}, urls)
+
+	fake.data["/solr/clusterprops.json"] = []byte(`{"urlScheme":"https"}`)
+	urls, err = source.LiveNodeURLs(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://10.0.0.1:8983", "https://10.0.0.2:8983"}, urls)
+
+	// Every lookup has its own session, closed once it is done.
+	assert.Equal(t, 2, fake.sessions)
+	assert.Equal(t, 2, fake.closed)
+
+	source, err = newZKLiveNodes([]string{"127.0.0.1:2181"}, "/missing")
+	require.NoError(t, err)
+	source.connect = fake.connect
+	_, err = source.LiveNodeURLs(context.Background())
+	assert.ErrorIs(t, err, zk.ErrNoNode)
+
+	_, err = newZKLiveNodes([]string{"127.0.0.1:2181"}, "solr/")
+	assert.Error(t, err)
+
+	_, err = newZKLiveNodes([]string{"zookeeper"}, "")
+	assert.Error(t, err)
+}
+
+func TestZKLiveNodesUnsupportedEnsemble(t *testing.T) {
+	fake := newFakeZooKeeper()
+	fake.err = zk.ErrNoAuth
+
+	source, err := newZKLiveNodes([]string{"127.0.0.1:2181"}, "")
+	require.NoError(t, err)
+	source.connect = fake.connect
+
+	_, err = source.LiveNodeURLs(context.Background())
+	assert.ErrorIs(t, err, zk.ErrNoAuth)
+	assert.ErrorContains(t, err, "not supported")
+
+	// An ensemble that never answers, such as one that requires SASL or
+	// TLS, times out and the session is closed.
+	defer func(timeout time.Duration) { zkSessionTimeout = timeout }(zkSessionTimeout)
+	zkSessionTimeout = 10 * time.Millisecond
+
+	fake.block = make(chan struct{})
+	defer close(fake.block)
+
+	_, err = source.LiveNodeURLs(context.Background())
+	assert.ErrorContains(t, err, "not supported")
+	assert.Equal(t, 2, fake.sessions)
+	assert.Equal(t, 2, fake.closed)
+}
+
+func TestClientZooKeeperDiscovery(t *testing.T) {
+	solr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"cluster":{"collections":{},"live_nodes":[]}}`))
+	}))
+	defer solr.Close()
+
+	fake := newFakeZooKeeper()
+	fake.children["/live_nodes"] = []string{solr.Listener.Addr().String() + "_solr"}
+
+	client, err := NewClient(ClientConfig{ZKHosts: []string{"127.0.0.1:2181"}})
+	require.NoError(t, err)
+	client.hosts.source.(*zkLiveNodes).connect = fake.connect
+
+	_, err = client.GetClusterStatus(context.Background(), ClusterStatusFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{solr.URL}, client.hosts.candidates())
+}
+
+func TestClientZooKeeperNodeRequests(t *testing.T) {
+	solr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"lucene":{"solr-spec-version":"9.4.0"}}`))
+	}))
+	defer solr.Close()
+
+	fake := newFakeZooKeeper()
+	fake.children["/live_nodes"] = []string{solr.Listener.Addr().String() + "_solr"}
+
+	client, err := NewClient(ClientConfig{ZKHosts: []string{"127.0.0.1:2181"}})
+	require.NoError(t, err)
+	client.hosts.source.(*zkLiveNodes).connect = fake.connect
+
+	// Requests meant for a single node go to a live node, not to the
+	// default host.
+	info, err := client.GetSystemInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "9.4.0", info.Lucene.SolrSpecVersion)
+}